@@ -0,0 +1,31 @@
+package flam
+
+import "context"
+
+type adminBooter struct {
+	kennel       *kennel
+	adminProcess *adminProcess
+}
+
+func newAdminBooter(
+	kennel *kennel,
+	adminProcess *adminProcess,
+) *adminBooter {
+	return &adminBooter{
+		kennel:       kennel,
+		adminProcess: adminProcess}
+}
+
+func (booter *adminBooter) Boot(
+	ctx context.Context,
+) error {
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+
+	if e := booter.kennel.add(booter.adminProcess); e != nil {
+		return e
+	}
+
+	return booter.kennel.Activate(booter.adminProcess.Id())
+}
@@ -0,0 +1,356 @@
+package flam
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.uber.org/dig"
+)
+
+type AdminProcess interface {
+	Process
+
+	Handler() http.Handler
+}
+
+type adminProcess struct {
+	mu               sync.Mutex
+	config           Config
+	redactor         *configRedactor
+	health           Health
+	logStreamFactory LogStreamFactory
+	kennel           Kennel
+	migratorFactory  MigratorFactory
+	server           *http.Server
+}
+
+var _ AdminProcess = (*adminProcess)(nil)
+
+func newAdminProcess(args struct {
+	dig.In
+
+	Config           Config
+	Redactor         *configRedactor
+	Health           Health
+	LogStreamFactory LogStreamFactory
+	Kennel           Kennel
+	MigratorFactory  MigratorFactory `optional:"true"`
+}) *adminProcess {
+	return &adminProcess{
+		config:           args.Config,
+		redactor:         args.Redactor,
+		health:           args.Health,
+		logStreamFactory: args.LogStreamFactory,
+		kennel:           args.Kennel,
+		migratorFactory:  args.MigratorFactory}
+}
+
+func (process *adminProcess) Id() string {
+	return AdminProcessId
+}
+
+func (process *adminProcess) IsRunning() bool {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+
+	return process.server != nil
+}
+
+func (process *adminProcess) Run() error {
+	process.mu.Lock()
+	server := &http.Server{
+		Addr:    process.config.String(PathAdminAddress, DefaultAdminAddress),
+		Handler: process.Handler()}
+	process.server = server
+	process.mu.Unlock()
+
+	e := server.ListenAndServe()
+
+	process.mu.Lock()
+	process.server = nil
+	process.mu.Unlock()
+
+	if errors.Is(e, http.ErrServerClosed) {
+		return nil
+	}
+
+	return e
+}
+
+func (process *adminProcess) Terminate() {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+
+	if process.server != nil {
+		// Error ignored - the server termination is reported by the Run call
+		_ = process.server.Close()
+	}
+}
+
+func (process *adminProcess) Close() error {
+	process.Terminate()
+
+	return nil
+}
+
+func (process *adminProcess) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", process.getHealth)
+	mux.HandleFunc("GET /config", process.getConfig)
+	mux.HandleFunc("GET /log/streams", process.listLogStreams)
+	mux.HandleFunc("PUT /log/streams/{id}/level", process.setLogStreamLevel)
+	mux.HandleFunc("GET /processes", process.listProcesses)
+	mux.HandleFunc("POST /processes/{id}/activate", process.activateProcess)
+	mux.HandleFunc("POST /processes/{id}/deactivate", process.deactivateProcess)
+	mux.HandleFunc("GET /migrators", process.listMigrators)
+	mux.HandleFunc("GET /migrators/{id}", process.getMigrator)
+
+	return mux
+}
+
+func (process *adminProcess) getHealth(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	report := process.health.Check(request.Context())
+
+	var checks []Bag
+	for _, check := range report.Checks {
+		entry := Bag{
+			"id":       check.Id,
+			"status":   check.Status.String(),
+			"duration": check.Duration.String()}
+		if check.Error != nil {
+			entry["error"] = check.Error.Error()
+		}
+		checks = append(checks, entry)
+	}
+
+	status := http.StatusOK
+	if report.Status != HealthStatusUp {
+		status = http.StatusServiceUnavailable
+	}
+
+	process.write(writer, status, Bag{
+		"status": report.Status.String(),
+		"checks": checks})
+}
+
+func (process *adminProcess) getConfig(
+	writer http.ResponseWriter,
+	_ *http.Request,
+) {
+	process.write(writer, http.StatusOK, process.redactor.config(process.config))
+}
+
+func (process *adminProcess) listLogStreams(
+	writer http.ResponseWriter,
+	_ *http.Request,
+) {
+	var streams []Bag
+	for _, id := range process.logStreamFactory.Stored() {
+		stream, e := process.logStreamFactory.Get(id)
+		if e != nil {
+			process.fail(writer, e)
+			return
+		}
+
+		streams = append(streams, Bag{
+			"id":       id,
+			"level":    stream.GetLevel().String(),
+			"channels": stream.ListChannels()})
+	}
+
+	process.write(writer, http.StatusOK, streams)
+}
+
+func (process *adminProcess) setLogStreamLevel(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	id := request.PathValue("id")
+
+	var body struct {
+		Level string `json:"level"`
+	}
+	if e := json.NewDecoder(request.Body).Decode(&body); e != nil {
+		process.write(writer, http.StatusBadRequest, Bag{"error": "invalid level"})
+		return
+	}
+
+	level := LogLevelFrom(body.Level)
+	if level.String() != strings.ToLower(body.Level) {
+		process.write(writer, http.StatusBadRequest, Bag{"error": "invalid level"})
+		return
+	}
+
+	if !slices.Contains(process.logStreamFactory.Stored(), id) {
+		process.fail(writer, newErrUnknownResource("LogStream", id))
+		return
+	}
+
+	stream, e := process.logStreamFactory.Get(id)
+	if e != nil {
+		process.fail(writer, e)
+		return
+	}
+
+	if e := stream.SetLevel(level); e != nil {
+		process.fail(writer, e)
+		return
+	}
+
+	process.write(writer, http.StatusOK, Bag{
+		"id":    id,
+		"level": stream.GetLevel().String()})
+}
+
+func (process *adminProcess) listProcesses(
+	writer http.ResponseWriter,
+	_ *http.Request,
+) {
+	ids := process.kennel.Available()
+	slices.Sort(ids)
+
+	var processes []Bag
+	for _, id := range ids {
+		processes = append(processes, Bag{
+			"id":      id,
+			"active":  process.kennel.IsActive(id),
+			"running": process.kennel.IsRunning(id)})
+	}
+
+	process.write(writer, http.StatusOK, processes)
+}
+
+func (process *adminProcess) activateProcess(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	id := request.PathValue("id")
+	if e := process.kennel.Activate(id); e != nil {
+		process.fail(writer, e)
+		return
+	}
+
+	process.write(writer, http.StatusOK, Bag{
+		"id":     id,
+		"active": true})
+}
+
+func (process *adminProcess) deactivateProcess(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	id := request.PathValue("id")
+	if e := process.kennel.Deactivate(id); e != nil {
+		process.fail(writer, e)
+		return
+	}
+
+	process.write(writer, http.StatusOK, Bag{
+		"id":     id,
+		"active": false})
+}
+
+func (process *adminProcess) listMigrators(
+	writer http.ResponseWriter,
+	_ *http.Request,
+) {
+	if process.migratorFactory == nil {
+		process.write(writer, http.StatusOK, []Bag(nil))
+		return
+	}
+
+	var migrators []Bag
+	for _, id := range process.migratorFactory.Available() {
+		migrations, e := process.migrations(id)
+		if e != nil {
+			process.fail(writer, e)
+			return
+		}
+
+		migrators = append(migrators, Bag{
+			"id":         id,
+			"migrations": migrations})
+	}
+
+	process.write(writer, http.StatusOK, migrators)
+}
+
+func (process *adminProcess) getMigrator(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	id := request.PathValue("id")
+
+	migrations, e := process.migrations(id)
+	if e != nil {
+		process.fail(writer, e)
+		return
+	}
+
+	process.write(writer, http.StatusOK, Bag{
+		"id":         id,
+		"migrations": migrations})
+}
+
+func (process *adminProcess) migrations(
+	id string,
+) ([]Bag, error) {
+	if process.migratorFactory == nil {
+		return nil, newErrUnknownResource("Migrator", id)
+	}
+
+	migrator, e := process.migratorFactory.Get(id)
+	if e != nil {
+		return nil, e
+	}
+
+	infos, e := migrator.List()
+	if e != nil {
+		return nil, e
+	}
+
+	var migrations []Bag
+	for _, info := range infos {
+		migrations = append(migrations, Bag{
+			"version":      info.Version,
+			"description":  info.Description,
+			"installed_at": info.InstalledAt})
+	}
+
+	return migrations, nil
+}
+
+func (process *adminProcess) fail(
+	writer http.ResponseWriter,
+	e error,
+) {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(e, ErrUnknownResource),
+		errors.Is(e, ErrProcessNotFound):
+		status = http.StatusNotFound
+	case errors.Is(e, ErrProcessIsRunning):
+		status = http.StatusConflict
+	}
+
+	process.write(writer, status, Bag{"error": e.Error()})
+}
+
+func (process *adminProcess) write(
+	writer http.ResponseWriter,
+	status int,
+	data any,
+) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+
+	// Error ignored - the response status has already been sent to the client
+	_ = json.NewEncoder(writer).Encode(data)
+}
@@ -1,27 +1,45 @@
 package flam
 
 import (
+	"context"
+	"io"
+	"os"
+	"os/signal"
 	"slices"
 	"sync"
+	"syscall"
 
 	"go.uber.org/dig"
 )
 
 type Application interface {
 	Container() *dig.Container
+	State() ApplicationState
 	HasProvider(id string) bool
 	Register(provider Provider) error
 	Boot() error
+	BootContext(ctx context.Context) error
 	Run() error
+	RunContext(ctx context.Context) error
+	Serve(ctx context.Context) error
 	Close() error
+	CloseContext(ctx context.Context) error
+	Restart() error
+	RestartContext(ctx context.Context) error
+	Execute(args []string) error
+	Registrations(providers ...string) []ContainerEntry
+	Graph(writer io.Writer) error
+	Verify() error
 }
 
 type application struct {
+	lifecycle sync.Mutex
 	mu        sync.Mutex
 	config    Bag
 	container *dig.Container
+	registry  *containerRegistry
 	providers []Provider
-	isBooted  bool
+	state     ApplicationState
 }
 
 var _ Application = (*application)(nil)
@@ -32,10 +50,12 @@ func NewApplication(
 	app := &application{
 		config:    append(config, Bag{})[0],
 		container: dig.New(),
+		registry:  newContainerRegistry(),
 		providers: []Provider{},
-		isBooted:  false}
+		state:     ApplicationStateRegistered}
 
-	_ = app.Register(newProvider())
+	_ = app.container.Provide(func() *containerRegistry { return app.registry })
+	_ = app.Register(newProvider(app.config))
 
 	return app
 }
@@ -44,6 +64,13 @@ func (app *application) Container() *dig.Container {
 	return app.container
 }
 
+func (app *application) State() ApplicationState {
+	app.mu.Lock()
+	defer app.mu.Unlock()
+
+	return app.state
+}
+
 func (app *application) HasProvider(id string) bool {
 	app.mu.Lock()
 	defer app.mu.Unlock()
@@ -67,12 +94,18 @@ func (app *application) Register(
 	app.mu.Lock()
 	defer app.mu.Unlock()
 
+	if app.state != ApplicationStateRegistered {
+		return newErrInvalidApplicationState(app.state, "register")
+	}
+
+	id := provider.Id()
 	for _, registered := range app.providers {
-		if registered.Id() == provider.Id() {
-			return newErrDuplicateProvider(provider.Id())
+		if registered.Id() == id {
+			return newErrDuplicateProvider(id)
 		}
 	}
 
+	app.registry.use(id)
 	if e := provider.Register(app.container); e != nil {
 		return e
 	}
@@ -83,20 +116,53 @@ func (app *application) Register(
 }
 
 func (app *application) Boot() error {
+	return app.BootContext(context.Background())
+}
+
+func (app *application) BootContext(
+	ctx context.Context,
+) error {
+	app.lifecycle.Lock()
+	defer app.lifecycle.Unlock()
+
 	app.mu.Lock()
-	defer app.mu.Unlock()
+	booting := app.state == ApplicationStateRegistered
+	e := app.boot(ctx)
+	app.mu.Unlock()
 
-	if app.isBooted {
+	if booting && e == nil {
+		app.publish(EventApplicationBooted)
+	}
+
+	return e
+}
+
+func (app *application) boot(
+	ctx context.Context,
+) error {
+	switch app.state {
+	case ApplicationStateBooted, ApplicationStateRunning:
 		return nil
+	case ApplicationStateClosed:
+		return newErrInvalidApplicationState(app.state, "boot")
+	}
+
+	providers, e := app.sortProviders()
+	if e != nil {
+		return e
 	}
 
 	config := &Bag{}
-	for _, provider := range app.providers {
+	schema := ConfigSchema{}
+	for _, provider := range providers {
 		if configurable, ok := provider.(ConfigurableProvider); ok {
 			if e := configurable.Config(config); e != nil {
 				return e
 			}
 		}
+		if schematic, ok := provider.(ConfigSchemaProvider); ok {
+			schema = append(schema, schematic.ConfigSchema()...)
+		}
 	}
 	config.Merge(app.config)
 
@@ -110,56 +176,321 @@ func (app *application) Boot() error {
 		return e
 	}
 
-	for _, provider := range app.providers {
-		if bootable, ok := provider.(BootableProvider); ok {
-			if e := bootable.Boot(app.container); e != nil {
-				return e
-			}
+	if e := app.container.Invoke(func(validator *configValidator) {
+		validator.set(schema)
+	}); e != nil {
+		return e
+	}
+
+	for _, provider := range providers {
+		if e := ctx.Err(); e != nil {
+			return e
+		}
+
+		var e error
+		switch bootable := provider.(type) {
+		case ContextBootableProvider:
+			e = bootable.BootContext(ctx, app.container)
+		case BootableProvider:
+			e = bootable.Boot(app.container)
+		}
+		if e != nil {
+			return e
 		}
 	}
 
-	app.isBooted = true
+	if config.Bool(PathContainerVerify) {
+		if e := app.registry.verify(app.container); e != nil {
+			return e
+		}
+	}
+
+	app.state = ApplicationStateBooted
 
 	return nil
 }
 
 func (app *application) Run() error {
-	if !app.isBooted {
-		if e := app.Boot(); e != nil {
+	return app.RunContext(context.Background())
+}
+
+func (app *application) RunContext(
+	ctx context.Context,
+) error {
+	app.lifecycle.Lock()
+	app.mu.Lock()
+	booting := app.state == ApplicationStateRegistered
+	if e := app.boot(ctx); e != nil {
+		app.mu.Unlock()
+		app.lifecycle.Unlock()
+		return e
+	}
+
+	if app.state != ApplicationStateBooted {
+		app.mu.Unlock()
+		app.lifecycle.Unlock()
+		return newErrInvalidApplicationState(app.state, "run")
+	}
+
+	providers, e := app.sortProviders()
+	if e != nil {
+		app.mu.Unlock()
+		app.lifecycle.Unlock()
+		return e
+	}
+	app.state = ApplicationStateRunning
+	app.mu.Unlock()
+
+	if booting {
+		app.publish(EventApplicationBooted)
+	}
+	app.publish(EventApplicationRunning)
+	app.lifecycle.Unlock()
+
+	for _, provider := range providers {
+		if e := ctx.Err(); e != nil {
+			return e
+		}
+
+		var e error
+		switch runnable := provider.(type) {
+		case ContextRunnableProvider:
+			e = runnable.RunContext(ctx, app.container)
+		case RunnableProvider:
+			e = runnable.Run(app.container)
+		}
+		if e != nil {
 			return e
 		}
 	}
 
-	app.mu.Lock()
-	defer app.mu.Unlock()
+	return nil
+}
 
-	for _, provider := range app.providers {
-		if runnable, ok := provider.(RunnableProvider); ok {
-			if e := runnable.Run(app.container); e != nil {
-				return e
-			}
+func (app *application) Serve(
+	ctx context.Context,
+) error {
+	if e := app.BootContext(ctx); e != nil {
+		return e
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.RunContext(ctx)
+	}()
+
+	var runE error
+	select {
+	case runE = <-runErr:
+		if runE == nil {
+			<-ctx.Done()
 		}
+	case <-ctx.Done():
 	}
 
-	return nil
+	timeout := DefaultShutdownTimeout
+	_ = app.container.Invoke(func(config Config) {
+		timeout = config.Duration(PathShutdownTimeout, DefaultShutdownTimeout)
+	})
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return NewMultiError(ErrServeFailed).
+		Add("run", runE).
+		Add("close", app.CloseContext(shutdownCtx)).
+		ErrorOrNil()
 }
 
 func (app *application) Close() error {
+	return app.CloseContext(context.Background())
+}
+
+func (app *application) CloseContext(
+	ctx context.Context,
+) error {
+	app.lifecycle.Lock()
+	defer app.lifecycle.Unlock()
+
+	if app.State() != ApplicationStateClosed {
+		app.publish(EventApplicationClosing)
+	}
+
 	app.mu.Lock()
 	defer app.mu.Unlock()
 
-	slices.Reverse(app.providers)
-	var errors []error
+	return app.close(ctx)
+}
+
+func (app *application) close(
+	ctx context.Context,
+) error {
+	if app.state == ApplicationStateClosed {
+		return nil
+	}
+	app.state = ApplicationStateClosed
+
+	providers, e := app.sortProviders()
+	if e != nil {
+		providers = slices.Clone(app.providers)
+	}
+	slices.Reverse(providers)
+
+	errs := NewMultiError(ErrCloseFailed)
+	for _, provider := range providers {
+		var closer func() error
+		switch closable := provider.(type) {
+		case ContextClosableProvider:
+			closer = func() error { return closable.CloseContext(ctx, app.container) }
+		case ClosableProvider:
+			closer = func() error { return closable.Close(app.container) }
+		default:
+			continue
+		}
+
+		if ctx.Err() != nil {
+			errs.Add(provider.Id(), ErrProviderCloseTimeout)
+			continue
+		}
+
+		closeErr := make(chan error, 1)
+		go func() {
+			closeErr <- closer()
+		}()
+
+		select {
+		case e := <-closeErr:
+			errs.Add(provider.Id(), e)
+		case <-ctx.Done():
+			errs.Add(provider.Id(), ErrProviderCloseTimeout)
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (app *application) Restart() error {
+	return app.RestartContext(context.Background())
+}
+
+func (app *application) RestartContext(
+	ctx context.Context,
+) error {
+	app.lifecycle.Lock()
+	defer app.lifecycle.Unlock()
+
+	state := app.State()
+	started := state == ApplicationStateBooted || state == ApplicationStateRunning
+	if started {
+		app.publish(EventApplicationClosing)
+	}
+
+	app.mu.Lock()
+	var e error
+	if started {
+		e = app.close(ctx)
+	}
+	app.state = ApplicationStateRegistered
+	if e == nil {
+		e = app.boot(ctx)
+	}
+	app.mu.Unlock()
+
+	if e == nil {
+		app.publish(EventApplicationBooted)
+	}
+
+	return e
+}
+
+func (app *application) publish(
+	channel string,
+) {
+	// Error ignored - the pubsub may not be registered when the core provider is not in use
+	_ = app.container.Invoke(func(pubSub PubSub[string, string]) {
+		publish(pubSub, channel)
+	})
+}
+
+func (app *application) Execute(
+	args []string,
+) error {
+	if e := app.Boot(); e != nil {
+		return e
+	}
+
+	return app.container.Invoke(func(commander Commander) error {
+		return commander.Execute(args, os.Stdout)
+	})
+}
+
+func (app *application) Registrations(
+	providers ...string,
+) []ContainerEntry {
+	return app.registry.list(providers...)
+}
+
+func (app *application) Graph(
+	writer io.Writer,
+) error {
+	return dig.Visualize(app.container, writer)
+}
+
+func (app *application) Verify() error {
+	return app.registry.verify(app.container)
+}
+
+func (app *application) sortProviders() ([]Provider, error) {
+	registered := map[string]Provider{}
 	for _, provider := range app.providers {
-		if closable, ok := provider.(ClosableProvider); ok {
-			if e := closable.Close(app.container); e != nil {
-				errors = append(errors, e)
+		registered[provider.Id()] = provider
+	}
+
+	var sorted []Provider
+	visited := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var visit func(provider Provider, path []string) error
+	visit = func(provider Provider, path []string) error {
+		id := provider.Id()
+		path = append(slices.Clone(path), id)
+
+		switch {
+		case visited[id]:
+			return nil
+		case visiting[id]:
+			return newErrCyclicProviderDependency(path)
+		}
+
+		visiting[id] = true
+		if dependent, ok := provider.(DependentProvider); ok {
+			for _, dependency := range dependent.Dependencies() {
+				required, ok := registered[dependency]
+				if !ok {
+					return newErrUnknownProviderDependency(id, dependency)
+				}
+
+				if e := visit(required, path); e != nil {
+					return e
+				}
 			}
 		}
+		visiting[id] = false
+		visited[id] = true
+
+		sorted = append(sorted, provider)
+
+		return nil
 	}
-	if len(errors) > 0 {
-		return newErrPublishFailed(errors)
+
+	for _, provider := range app.providers {
+		if e := visit(provider, nil); e != nil {
+			return nil, e
+		}
 	}
 
-	return nil
+	return sorted, nil
 }
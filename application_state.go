@@ -0,0 +1,25 @@
+package flam
+
+type ApplicationState int
+
+const (
+	ApplicationStateRegistered ApplicationState = iota
+	ApplicationStateBooted
+	ApplicationStateRunning
+	ApplicationStateClosed
+)
+
+func (state ApplicationState) String() string {
+	switch state {
+	case ApplicationStateRegistered:
+		return "registered"
+	case ApplicationStateBooted:
+		return "booted"
+	case ApplicationStateRunning:
+		return "running"
+	case ApplicationStateClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
@@ -23,6 +23,7 @@ type cacheAdaptorFactoryArgs struct {
 
 	Creators      []CacheAdaptorCreator `group:"flam.cache.adaptors.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newCacheAdaptorFactory(
@@ -37,5 +38,6 @@ func newCacheAdaptorFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("CacheAdaptor"),
-		PathCacheAdaptors)
+		PathCacheAdaptors,
+		args.PubSub)
 }
@@ -23,6 +23,7 @@ type cacheKeyGeneratorFactoryArgs struct {
 
 	Creators      []CacheKeyGeneratorCreator `group:"flam.cache.key_generators.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newCacheKeyGeneratorFactory(
@@ -37,5 +38,6 @@ func newCacheKeyGeneratorFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("CacheKeyGenerator"),
-		PathCacheKeyGenerators)
+		PathCacheKeyGenerators,
+		args.PubSub)
 }
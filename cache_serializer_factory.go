@@ -23,6 +23,7 @@ type cacheSerializerFactoryArgs struct {
 
 	Creators      []CacheSerializerCreator `group:"flam.cache.serializers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newCacheSerializerFactory(
@@ -37,5 +38,6 @@ func newCacheSerializerFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("CacheSerializer"),
-		PathCacheSerializers)
+		PathCacheSerializers,
+		args.PubSub)
 }
@@ -0,0 +1,10 @@
+package flam
+
+import "io"
+
+type Command interface {
+	Name() string
+	Description() string
+	Usage() string
+	Run(args []string, output io.Writer) error
+}
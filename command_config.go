@@ -0,0 +1,172 @@
+package flam
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"go.uber.org/dig"
+)
+
+type configSourcesCommand struct {
+	configSourceFactory ConfigSourceFactory
+}
+
+var _ Command = (*configSourcesCommand)(nil)
+
+func newConfigSourcesCommand(
+	configSourceFactory ConfigSourceFactory,
+) Command {
+	return &configSourcesCommand{
+		configSourceFactory: configSourceFactory}
+}
+
+func (command configSourcesCommand) Name() string {
+	return CommandConfigSources
+}
+
+func (command configSourcesCommand) Description() string {
+	return "list the loaded config sources and their priority"
+}
+
+func (command configSourcesCommand) Usage() string {
+	return CommandConfigSources
+}
+
+func (command configSourcesCommand) Run(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) != 0 {
+		return newErrInvalidCommandArguments(command.Usage(), args)
+	}
+
+	// Errors ignored - the buffered writer reports any write error on flush
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tPRIORITY")
+	for _, id := range command.configSourceFactory.Stored() {
+		source, e := command.configSourceFactory.Get(id)
+		if e != nil {
+			return e
+		}
+
+		_, _ = fmt.Fprintf(writer, "%s\t%d\n", id, source.GetPriority())
+	}
+
+	return writer.Flush()
+}
+
+type configDumpCommand struct {
+	config   Config
+	redactor *configRedactor
+}
+
+var _ Command = (*configDumpCommand)(nil)
+
+func newConfigDumpCommand(
+	config Config,
+	redactor *configRedactor,
+) Command {
+	return &configDumpCommand{
+		config:   config,
+		redactor: redactor}
+}
+
+func (command configDumpCommand) Name() string {
+	return CommandConfigDump
+}
+
+func (command configDumpCommand) Description() string {
+	return "dump the aggregated config with the sensitive entries redacted"
+}
+
+func (command configDumpCommand) Usage() string {
+	return CommandConfigDump + " [path]"
+}
+
+func (command configDumpCommand) Run(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) > 1 {
+		return newErrInvalidCommandArguments(command.Usage(), args)
+	}
+
+	config := command.redactor.config(command.config)
+
+	var data any = config
+	if len(args) == 1 {
+		data = config.Get(args[0])
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(data)
+}
+
+type configEncryptCommand struct {
+	config Config
+	input  io.Reader
+}
+
+var _ Command = (*configEncryptCommand)(nil)
+
+func newConfigEncryptCommand(args struct {
+	dig.In
+
+	Config Config
+	Input  io.Reader `name:"flam.commands.input" optional:"true"`
+}) Command {
+	input := args.Input
+	if input == nil {
+		input = os.Stdin
+	}
+
+	return &configEncryptCommand{
+		config: args.Config,
+		input:  input}
+}
+
+func (command configEncryptCommand) Name() string {
+	return CommandConfigEncrypt
+}
+
+func (command configEncryptCommand) Description() string {
+	return "encrypt the standard input value with a configured encryption key"
+}
+
+func (command configEncryptCommand) Usage() string {
+	return CommandConfigEncrypt + " <key_id>"
+}
+
+func (command configEncryptCommand) Run(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) != 1 {
+		return newErrInvalidCommandArguments(command.Usage(), args)
+	}
+
+	key := command.config.String(PathConfigEncryptionKeys + "." + args[0])
+	if key == "" {
+		return newErrUnknownResource("ConfigEncryptionKey", args[0])
+	}
+
+	plain, e := io.ReadAll(command.input)
+	if e != nil {
+		return e
+	}
+
+	value, e := EncryptConfigValue(args[0], key, strings.TrimRight(string(plain), "\r\n"))
+	if e != nil {
+		return e
+	}
+
+	_, e = fmt.Fprintln(output, value)
+
+	return e
+}
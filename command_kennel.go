@@ -0,0 +1,54 @@
+package flam
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+)
+
+type kennelProcessesCommand struct {
+	kennel Kennel
+}
+
+var _ Command = (*kennelProcessesCommand)(nil)
+
+func newKennelProcessesCommand(
+	kennel Kennel,
+) Command {
+	return &kennelProcessesCommand{
+		kennel: kennel}
+}
+
+func (command kennelProcessesCommand) Name() string {
+	return CommandKennelProcesses
+}
+
+func (command kennelProcessesCommand) Description() string {
+	return "list the kennel processes and their state"
+}
+
+func (command kennelProcessesCommand) Usage() string {
+	return CommandKennelProcesses
+}
+
+func (command kennelProcessesCommand) Run(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) != 0 {
+		return newErrInvalidCommandArguments(command.Usage(), args)
+	}
+
+	ids := command.kennel.Available()
+	slices.Sort(ids)
+
+	// Errors ignored - the buffered writer reports any write error on flush
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tACTIVE\tRUNNING")
+	for _, id := range ids {
+		_, _ = fmt.Fprintf(writer, "%s\t%t\t%t\n", id, command.kennel.IsActive(id), command.kennel.IsRunning(id))
+	}
+
+	return writer.Flush()
+}
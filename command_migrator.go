@@ -0,0 +1,162 @@
+package flam
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+)
+
+type migratorCommand struct {
+	name            string
+	description     string
+	migratorFactory MigratorFactory
+	action          func(migrator Migrator, output io.Writer) error
+}
+
+var _ Command = (*migratorCommand)(nil)
+
+func newMigratorListCommand(
+	migratorFactory MigratorFactory,
+) Command {
+	return &migratorCommand{
+		name:            CommandMigratorList,
+		description:     "list a migrator migrations and their installation time",
+		migratorFactory: migratorFactory,
+		action:          listMigrations}
+}
+
+func newMigratorUpCommand(
+	migratorFactory MigratorFactory,
+) Command {
+	return &migratorCommand{
+		name:            CommandMigratorUp,
+		description:     "execute the next pending migration of a migrator",
+		migratorFactory: migratorFactory,
+		action: func(migrator Migrator, output io.Writer) error {
+			if e := migrator.Up(); e != nil {
+				return e
+			}
+
+			return currentMigration(migrator, output)
+		}}
+}
+
+func newMigratorUpAllCommand(
+	migratorFactory MigratorFactory,
+) Command {
+	return &migratorCommand{
+		name:            CommandMigratorUpAll,
+		description:     "execute all pending migrations of a migrator",
+		migratorFactory: migratorFactory,
+		action: func(migrator Migrator, output io.Writer) error {
+			if e := migrator.UpAll(); e != nil {
+				return e
+			}
+
+			return currentMigration(migrator, output)
+		}}
+}
+
+func newMigratorDownCommand(
+	migratorFactory MigratorFactory,
+) Command {
+	return &migratorCommand{
+		name:            CommandMigratorDown,
+		description:     "revert the last executed migration of a migrator",
+		migratorFactory: migratorFactory,
+		action: func(migrator Migrator, output io.Writer) error {
+			if e := migrator.Down(); e != nil {
+				return e
+			}
+
+			return currentMigration(migrator, output)
+		}}
+}
+
+func newMigratorDownAllCommand(
+	migratorFactory MigratorFactory,
+) Command {
+	return &migratorCommand{
+		name:            CommandMigratorDownAll,
+		description:     "revert all executed migrations of a migrator",
+		migratorFactory: migratorFactory,
+		action: func(migrator Migrator, output io.Writer) error {
+			if e := migrator.DownAll(); e != nil {
+				return e
+			}
+
+			return currentMigration(migrator, output)
+		}}
+}
+
+func (command migratorCommand) Name() string {
+	return command.name
+}
+
+func (command migratorCommand) Description() string {
+	return command.description
+}
+
+func (command migratorCommand) Usage() string {
+	return command.name + " <migrator_id>"
+}
+
+func (command migratorCommand) Run(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) != 1 {
+		return newErrInvalidCommandArguments(command.Usage(), args)
+	}
+
+	migrator, e := command.migratorFactory.Get(args[0])
+	if e != nil {
+		return e
+	}
+
+	return command.action(migrator, output)
+}
+
+func listMigrations(
+	migrator Migrator,
+	output io.Writer,
+) error {
+	migrations, e := migrator.List()
+	if e != nil {
+		return e
+	}
+
+	// Errors ignored - the buffered writer reports any write error on flush
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "VERSION\tDESCRIPTION\tINSTALLED AT")
+	for _, migration := range migrations {
+		installedAt := "-"
+		if migration.InstalledAt != nil {
+			installedAt = migration.InstalledAt.Format(time.RFC3339)
+		}
+
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\n", migration.Version, migration.Description, installedAt)
+	}
+
+	return writer.Flush()
+}
+
+func currentMigration(
+	migrator Migrator,
+	output io.Writer,
+) error {
+	current, e := migrator.Current()
+	if e != nil {
+		return e
+	}
+
+	version := "-"
+	if current != nil {
+		version = current.Version
+	}
+
+	_, e = fmt.Fprintf(output, "current migration: %s\n", version)
+
+	return e
+}
@@ -0,0 +1,109 @@
+package flam
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"go.uber.org/dig"
+)
+
+type Commander interface {
+	Commands() []string
+	Execute(args []string, output io.Writer) error
+}
+
+type commander struct {
+	commands map[string]Command
+}
+
+var _ Commander = (*commander)(nil)
+
+func newCommander(args struct {
+	dig.In
+
+	Commands []Command `group:"flam.commands"`
+}) (*commander, error) {
+	commander := &commander{
+		commands: map[string]Command{}}
+
+	for _, command := range args.Commands {
+		name := command.Name()
+		if _, ok := commander.commands[name]; ok {
+			return nil, newErrDuplicateCommand(name)
+		}
+
+		commander.commands[name] = command
+	}
+
+	return commander, nil
+}
+
+func (commander *commander) Commands() []string {
+	var names []string
+	for name := range commander.commands {
+		names = append(names, name)
+	}
+
+	slices.SortFunc(names, strings.Compare)
+
+	return names
+}
+
+func (commander *commander) Execute(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) == 0 || args[0] == CommandHelp {
+		return commander.help(args[min(len(args), 1):], output)
+	}
+
+	command, ok := commander.commands[args[0]]
+	if !ok {
+		// Error ignored - the unknown command error takes precedence over the usage output
+		_, _ = fmt.Fprint(output, commander.usage())
+
+		return newErrUnknownCommand(args[0])
+	}
+
+	return command.Run(args[1:], output)
+}
+
+func (commander *commander) help(
+	args []string,
+	output io.Writer,
+) error {
+	if len(args) == 0 {
+		_, e := fmt.Fprint(output, commander.usage())
+		return e
+	}
+
+	command, ok := commander.commands[args[0]]
+	if !ok {
+		// Error ignored - the unknown command error takes precedence over the usage output
+		_, _ = fmt.Fprint(output, commander.usage())
+
+		return newErrUnknownCommand(args[0])
+	}
+
+	_, e := fmt.Fprintf(output, "usage: %s\n\n%s\n", command.Usage(), command.Description())
+
+	return e
+}
+
+func (commander *commander) usage() string {
+	width := len(CommandHelp)
+	for name := range commander.commands {
+		width = max(width, len(name))
+	}
+
+	var builder strings.Builder
+	builder.WriteString("usage: <command> [arguments]\n\ncommands:\n")
+	builder.WriteString(fmt.Sprintf("  %-*s  %s\n", width, CommandHelp, "show the commands list or a command usage"))
+	for _, name := range commander.Commands() {
+		builder.WriteString(fmt.Sprintf("  %-*s  %s\n", width, name, commander.commands[name].Description()))
+	}
+
+	return builder.String()
+}
@@ -1,5 +1,7 @@
 package flam
 
+import "context"
+
 type configBooter struct {
 	config              Config
 	configSourceFactory ConfigSourceFactory
@@ -14,13 +16,18 @@ func newConfigBooter(
 		configSourceFactory: configSourceFactory}
 }
 
-func (booter *configBooter) Boot() error {
+func (booter *configBooter) Boot(
+	ctx context.Context,
+) error {
 	if !booter.config.Bool(PathConfigBoot) {
 		return nil
 	}
 
 	for id := range booter.config.Bag(PathConfigSources) {
-		_, e := booter.configSourceFactory.Get(id)
+		e := withContext(ctx, func() error {
+			_, e := booter.configSourceFactory.Get(id)
+			return e
+		})
 		if e != nil {
 			return e
 		}
@@ -0,0 +1,67 @@
+package flam
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func coerceConfigValue(
+	raw string,
+	list bool,
+) any {
+	value := strings.TrimSpace(raw)
+
+	if strings.HasPrefix(value, "{") || strings.HasPrefix(value, "[") {
+		var data any
+		if e := json.Unmarshal([]byte(value), &data); e == nil {
+			return BagNormalization(data)
+		}
+	}
+
+	switch strings.ToLower(value) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	if hasLeadingZero(value) {
+		return value
+	}
+
+	if i, e := strconv.Atoi(value); e == nil {
+		return i
+	}
+
+	if f, e := strconv.ParseFloat(value, 64); e == nil && !math.IsNaN(f) && !math.IsInf(f, 0) {
+		return f
+	}
+
+	if d, e := time.ParseDuration(value); e == nil {
+		return d
+	}
+
+	if list && strings.HasPrefix(value, "[") && strings.HasSuffix(value, "]") {
+		items := []any{}
+		if inner := strings.TrimSpace(value[1 : len(value)-1]); inner != "" {
+			for _, item := range strings.Split(inner, ",") {
+				items = append(items, coerceConfigValue(item, false))
+			}
+		}
+
+		return items
+	}
+
+	return value
+}
+
+func hasLeadingZero(
+	value string,
+) bool {
+	digits := strings.TrimLeft(value, "+-")
+
+	return len(digits) > 1 && digits[0] == '0' && digits[1] >= '0' && digits[1] <= '9'
+}
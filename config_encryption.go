@@ -0,0 +1,182 @@
+package flam
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"strings"
+)
+
+func GenerateConfigEncryptionKey() (string, error) {
+	key := make([]byte, 32)
+	if _, e := rand.Read(key); e != nil {
+		return "", e
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+func EncryptConfigValue(
+	keyId string,
+	key string,
+	value string,
+) (string, error) {
+	aead, e := newConfigEncryptionCipher(keyId, key)
+	if e != nil {
+		return "", e
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, e := rand.Read(nonce); e != nil {
+		return "", e
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(keyId))
+
+	return ConfigEncryptionPrefix + keyId + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func DecryptConfigValue(
+	value string,
+	keys map[string]string,
+) (string, error) {
+	payload, ok := strings.CutPrefix(value, ConfigEncryptionPrefix)
+	if !ok {
+		return value, nil
+	}
+
+	keyId, encoded, ok := strings.Cut(payload, ":")
+	if !ok {
+		return "", newErrInvalidEncryptedConfigValue("missing key id")
+	}
+
+	key, ok := keys[keyId]
+	if !ok {
+		return "", newErrUnknownResource("ConfigEncryptionKey", keyId)
+	}
+
+	aead, e := newConfigEncryptionCipher(keyId, key)
+	if e != nil {
+		return "", e
+	}
+
+	sealed, e := base64.StdEncoding.DecodeString(encoded)
+	if e != nil || len(sealed) < aead.NonceSize() {
+		return "", newErrInvalidEncryptedConfigValue(keyId)
+	}
+
+	plain, e := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(keyId))
+	if e != nil {
+		return "", newErrInvalidEncryptedConfigValue(keyId)
+	}
+
+	return string(plain), nil
+}
+
+func newConfigEncryptionCipher(
+	keyId string,
+	key string,
+) (cipher.AEAD, error) {
+	raw, e := base64.StdEncoding.DecodeString(key)
+	if e != nil {
+		return nil, newErrInvalidConfigEncryptionKey(keyId)
+	}
+
+	block, e := aes.NewCipher(raw)
+	if e != nil {
+		return nil, newErrInvalidConfigEncryptionKey(keyId)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func decryptConfig(
+	bag Bag,
+	decrypted map[string]struct{},
+) (Bag, error) {
+	keys := map[string]string{}
+	for keyId, key := range bag.Bag(PathConfigEncryptionKeys, Bag{}) {
+		if str, ok := key.(string); ok {
+			keys[keyId] = str
+			decrypted[PathConfigEncryptionKeys+"."+keyId] = struct{}{}
+		}
+	}
+
+	paths := asStrings(bag.Get(PathConfigEncryptionPaths))
+	encrypted := func(path string) bool {
+		if len(paths) == 0 {
+			return true
+		}
+
+		for _, p := range paths {
+			if path == p || strings.HasPrefix(path, p+".") {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	var decrypt func(value any, path string) (any, error)
+	decrypt = func(value any, path string) (any, error) {
+		switch typedValue := value.(type) {
+		case string:
+			if !strings.HasPrefix(typedValue, ConfigEncryptionPrefix) {
+				return value, nil
+			}
+
+			if !encrypted(path) {
+				return nil, newErrInvalidEncryptedConfigValue("out of the encryption paths: " + path)
+			}
+
+			plain, e := DecryptConfigValue(typedValue, keys)
+			if e != nil {
+				return nil, e
+			}
+
+			decrypted[path] = struct{}{}
+
+			return plain, nil
+		case []any:
+			var result []any
+			for _, i := range typedValue {
+				item, e := decrypt(i, path)
+				if e != nil {
+					return nil, e
+				}
+				result = append(result, item)
+			}
+
+			return result, nil
+		default:
+			b, ok := asBag(typedValue)
+			if !ok {
+				return value, nil
+			}
+
+			result := Bag{}
+			for key, i := range b {
+				itemPath := key
+				if path != "" {
+					itemPath = path + "." + key
+				}
+
+				item, e := decrypt(i, itemPath)
+				if e != nil {
+					return nil, e
+				}
+				result[key] = item
+			}
+
+			return result, nil
+		}
+	}
+
+	result, e := decrypt(bag, "")
+	if e != nil {
+		return nil, e
+	}
+
+	return result.(Bag), nil
+}
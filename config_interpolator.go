@@ -0,0 +1,171 @@
+package flam
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var (
+	configInterpolationRegex    = regexp.MustCompile(`\$\{([^{}]+)\}`)
+	configInterpolationEnvRegex = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+)
+
+type configInterpolator struct {
+	bag      Bag
+	resolved map[string]any
+	visiting []string
+}
+
+func interpolateConfig(
+	bag Bag,
+) (Bag, error) {
+	interpolator := &configInterpolator{
+		bag:      bag,
+		resolved: map[string]any{}}
+
+	result, e := interpolator.resolve(bag, "")
+	if e != nil {
+		return nil, e
+	}
+
+	return result.(Bag), nil
+}
+
+func (interpolator *configInterpolator) resolve(
+	value any,
+	path string,
+) (any, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return interpolator.resolveString(typedValue, path)
+	case []any:
+		var result []any
+		for i, item := range typedValue {
+			resolved, e := interpolator.resolve(item, fmt.Sprintf("%s.%d", path, i))
+			if e != nil {
+				return nil, e
+			}
+			result = append(result, resolved)
+		}
+
+		return result, nil
+	default:
+		bag, ok := asBag(typedValue)
+		if !ok {
+			return value, nil
+		}
+
+		result := Bag{}
+		for key, item := range bag {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+
+			resolved, e := interpolator.resolve(item, itemPath)
+			if e != nil {
+				return nil, e
+			}
+			result[key] = resolved
+		}
+
+		return result, nil
+	}
+}
+
+func (interpolator *configInterpolator) resolveString(
+	value string,
+	path string,
+) (any, error) {
+	if resolved, ok := interpolator.resolved[path]; ok {
+		return resolved, nil
+	}
+
+	for i, visiting := range interpolator.visiting {
+		if visiting == path {
+			return nil, newErrConfigInterpolationCycle(append(slices.Clone(interpolator.visiting[i:]), path))
+		}
+	}
+
+	matches := configInterpolationRegex.FindAllStringSubmatchIndex(value, -1)
+	if len(matches) == 0 {
+		return value, nil
+	}
+
+	interpolator.visiting = append(interpolator.visiting, path)
+	defer func() {
+		interpolator.visiting = interpolator.visiting[:len(interpolator.visiting)-1]
+	}()
+
+	var result any
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(value) {
+		resolved, e := interpolator.expression(value[matches[0][2]:matches[0][3]])
+		if e != nil {
+			return nil, e
+		}
+		result = resolved
+	} else {
+		var builder strings.Builder
+		last := 0
+		for _, match := range matches {
+			resolved, e := interpolator.expression(value[match[2]:match[3]])
+			if e != nil {
+				return nil, e
+			}
+
+			builder.WriteString(value[last:match[0]])
+			if resolved != nil {
+				builder.WriteString(fmt.Sprintf("%v", resolved))
+			}
+			last = match[1]
+		}
+		builder.WriteString(value[last:])
+		result = builder.String()
+	}
+
+	interpolator.resolved[path] = result
+
+	return result, nil
+}
+
+func (interpolator *configInterpolator) expression(
+	expression string,
+) (any, error) {
+	reference, def, hasDefault := strings.Cut(expression, ":")
+	reference = strings.TrimSpace(reference)
+
+	if configInterpolationEnvRegex.MatchString(reference) {
+		if env, ok := os.LookupEnv(reference); ok && env != "" {
+			return env, nil
+		}
+
+		if !hasDefault {
+			return nil, newErrUnresolvedConfigInterpolation(reference)
+		}
+
+		return def, nil
+	}
+
+	value := interpolator.bag.Get(strings.ToLower(reference), nil)
+	if value == nil {
+		if !hasDefault {
+			return nil, newErrUnresolvedConfigInterpolation(reference)
+		}
+
+		return def, nil
+	}
+
+	resolved, e := interpolator.resolve(value, strings.ToLower(reference))
+	if e != nil {
+		return nil, e
+	}
+
+	if bag, ok := asBag(resolved); ok {
+		return bag.Clone(), nil
+	}
+
+	return resolved, nil
+}
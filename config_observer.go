@@ -6,6 +6,7 @@ type configObserver struct {
 	config              Config
 	configSourceFactory ConfigSourceFactory
 	triggerFactory      TriggerFactory
+	configWatcher       *configWatcher
 	trigger             Trigger
 }
 
@@ -13,16 +14,24 @@ func newConfigObserver(
 	config Config,
 	configSourceFactory ConfigSourceFactory,
 	triggerFactory TriggerFactory,
+	configWatcher *configWatcher,
 ) *configObserver {
 	return &configObserver{
 		config:              config,
 		configSourceFactory: configSourceFactory,
-		triggerFactory:      triggerFactory}
+		triggerFactory:      triggerFactory,
+		configWatcher:       configWatcher}
 }
 
 func (observer *configObserver) Close() error {
+	// Error ignored - observer removal is cleanup, shouldn't block observer close
+	_ = observer.config.RemoveObserver("flam.config")
+
 	if observer.trigger != nil {
-		return observer.trigger.Close()
+		trigger := observer.trigger
+		observer.trigger = nil
+
+		return trigger.Close()
 	}
 
 	return nil
@@ -67,5 +76,14 @@ func (observer *configObserver) Boot() error {
 }
 
 func (observer *configObserver) Callback() error {
-	return observer.configSourceFactory.Reload()
+	if !observer.configWatcher.Watching() {
+		return observer.configSourceFactory.Reload()
+	}
+
+	unwatched := observer.configWatcher.Unwatched(observer.configSourceFactory.Stored())
+	if len(unwatched) == 0 {
+		return nil
+	}
+
+	return observer.configSourceFactory.Reload(unwatched...)
 }
@@ -23,6 +23,7 @@ type configParserFactoryArgs struct {
 
 	Creators      []ConfigParserCreator `group:"flam.config.parsers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newConfigParserFactory(
@@ -37,5 +38,6 @@ func newConfigParserFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("ConfigParser"),
-		PathConfigParsers)
+		PathConfigParsers,
+		args.PubSub)
 }
@@ -0,0 +1,227 @@
+package flam
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+var configRedactDefaultKeys = []string{"password", "secret", "token"}
+
+type configRedactor struct {
+	mu     sync.RWMutex
+	keys   []string
+	paths  map[string]struct{}
+	values *strings.Replacer
+}
+
+func newConfigRedactor() *configRedactor {
+	return &configRedactor{
+		keys:  configRedactDefaultKeys,
+		paths: map[string]struct{}{}}
+}
+
+func (redactor *configRedactor) update(
+	keys []string,
+	paths map[string]struct{},
+	values []string,
+) {
+	var replacements []string
+	slices.SortFunc(values, func(a, b string) int {
+		if len(a) != len(b) {
+			return len(b) - len(a)
+		}
+		return strings.Compare(a, b)
+	})
+	for _, value := range slices.Compact(values) {
+		if len(value) >= ConfigRedactMinValueLength {
+			replacements = append(replacements, value, ConfigRedactedValue)
+		}
+	}
+
+	var replacer *strings.Replacer
+	if len(replacements) != 0 {
+		replacer = strings.NewReplacer(replacements...)
+	}
+
+	redactor.mu.Lock()
+	defer redactor.mu.Unlock()
+
+	redactor.keys = keys
+	redactor.paths = paths
+	redactor.values = replacer
+}
+
+func (redactor *configRedactor) message(
+	message string,
+) string {
+	redactor.mu.RLock()
+	values := redactor.values
+	redactor.mu.RUnlock()
+
+	if values == nil {
+		return message
+	}
+
+	return values.Replace(message)
+}
+
+func (redactor *configRedactor) context(
+	ctx Bag,
+) Bag {
+	if len(ctx) == 0 {
+		return ctx
+	}
+
+	redactor.mu.RLock()
+	keys := redactor.keys
+	values := redactor.values
+	redactor.mu.RUnlock()
+
+	return redactBag(ctx, "", keys, nil, values).(Bag)
+}
+
+func (redactor *configRedactor) error(
+	e error,
+) error {
+	if e == nil {
+		return nil
+	}
+
+	message := e.Error()
+	if redacted := redactor.message(message); redacted != message {
+		return &redactedError{
+			error:   e,
+			message: redacted}
+	}
+
+	return e
+}
+
+func (redactor *configRedactor) config(
+	config Config,
+) Bag {
+	redactor.mu.RLock()
+	paths := redactor.paths
+	redactor.mu.RUnlock()
+
+	bag := config.Bag("")
+
+	return redactBag(bag.Clone(), "", configRedactKeys(config), paths, nil).(Bag)
+}
+
+type redactedError struct {
+	error
+	message string
+}
+
+func (e *redactedError) Error() string {
+	return e.message
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.error
+}
+
+func configRedactKeys(
+	config Config,
+) []string {
+	return asStrings(config.Get(PathConfigRedact))
+}
+
+func configRedactValues(
+	bag Bag,
+	paths map[string]struct{},
+) []string {
+	var values []string
+	var collect func(value any)
+	collect = func(value any) {
+		switch typedValue := value.(type) {
+		case string:
+			values = append(values, typedValue)
+		case []any:
+			for _, i := range typedValue {
+				collect(i)
+			}
+		default:
+			if b, ok := asBag(typedValue); ok {
+				for _, i := range b {
+					collect(i)
+				}
+			}
+		}
+	}
+
+	for path := range paths {
+		collect(bag.Get(path))
+	}
+
+	return values
+}
+
+func redactBag(
+	value any,
+	path string,
+	keys []string,
+	paths map[string]struct{},
+	values *strings.Replacer,
+) any {
+	switch typedValue := value.(type) {
+	case string:
+		if values == nil {
+			return value
+		}
+
+		return values.Replace(typedValue)
+	case []string:
+		var result []string
+		for _, i := range typedValue {
+			result = append(result, redactBag(i, path, keys, paths, values).(string))
+		}
+
+		return result
+	case []any:
+		var result []any
+		for _, i := range typedValue {
+			result = append(result, redactBag(i, path, keys, paths, values))
+		}
+
+		return result
+	default:
+		bag, ok := asBag(typedValue)
+		if !ok {
+			return value
+		}
+
+		result := Bag{}
+		for key, i := range bag {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+
+			if _, ok := paths[itemPath]; ok || redactKey(key, keys) {
+				result[key] = ConfigRedactedValue
+				continue
+			}
+
+			result[key] = redactBag(i, itemPath, keys, paths, values)
+		}
+
+		return result
+	}
+}
+
+func redactKey(
+	key string,
+	keys []string,
+) bool {
+	key = strings.ToLower(key)
+	for _, sensitive := range keys {
+		if strings.Contains(key, strings.ToLower(sensitive)) {
+			return true
+		}
+	}
+
+	return false
+}
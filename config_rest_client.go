@@ -0,0 +1,65 @@
+package flam
+
+import (
+	"io"
+	"net/http"
+	"time"
+)
+
+type configRestClient struct {
+	client   *http.Client
+	headers  map[string]string
+	token    string
+	username string
+	password string
+	attempts int
+	backoff  time.Duration
+}
+
+var _ ConfigRestClient = (*configRestClient)(nil)
+
+func (client *configRestClient) Do(
+	request *http.Request,
+) (*http.Response, error) {
+	for name, value := range client.headers {
+		request.Header.Set(name, value)
+	}
+
+	switch {
+	case client.token != "":
+		request.Header.Set("Authorization", "Bearer "+client.token)
+	case client.username != "":
+		request.SetBasicAuth(client.username, client.password)
+	}
+
+	var response *http.Response
+	var e error
+	for attempt := 0; attempt < client.attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-request.Context().Done():
+				return nil, request.Context().Err()
+			case <-time.After(client.backoff << (attempt - 1)):
+			}
+		}
+
+		response, e = client.client.Do(request)
+		if e == nil && !client.retryable(response.StatusCode) {
+			return response, nil
+		}
+
+		if e == nil && attempt < client.attempts-1 {
+			// Errors ignored - the discarded response is replaced by the next attempt
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+	}
+
+	return response, e
+}
+
+func (client *configRestClient) retryable(
+	status int,
+) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
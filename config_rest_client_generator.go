@@ -1,25 +1,120 @@
 package flam
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"net/http"
+
+	"github.com/spf13/afero"
 )
 
 type ConfigRestClientGenerator interface {
-	Create() (ConfigRestClient, error)
+	Create(config Bag) (ConfigRestClient, error)
 }
 
 type ConfigRestClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
-type configRestClientGenerator struct{}
+type configRestClientGenerator struct {
+	config      Config
+	diskFactory DiskFactory
+}
 
 var _ ConfigRestClientGenerator = (*configRestClientGenerator)(nil)
 
-func newConfigRestClientGenerator() ConfigRestClientGenerator {
-	return &configRestClientGenerator{}
+func newConfigRestClientGenerator(
+	config Config,
+	diskFactory DiskFactory,
+) ConfigRestClientGenerator {
+	return &configRestClientGenerator{
+		config:      config,
+		diskFactory: diskFactory}
+}
+
+func (generator configRestClientGenerator) Create(
+	config Bag,
+) (ConfigRestClient, error) {
+	attempts := config.Int("retry.attempts", generator.config.Int(PathConfigDefaultRestRetryAttempts, DefaultConfigRestRetryAttempts))
+	if attempts < 1 {
+		return nil, newErrInvalidResourceConfig("configRestClient", "retry.attempts", config)
+	}
+
+	headers := map[string]string{}
+	for name, value := range config.Bag("headers", Bag{}) {
+		if header, ok := value.(string); ok {
+			headers[name] = header
+		}
+	}
+
+	tlsConfig, e := generator.tlsConfig(config)
+	if e != nil {
+		return nil, e
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &configRestClient{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   config.Duration("timeout", generator.config.Duration(PathConfigDefaultRestTimeout, DefaultConfigRestTimeout))},
+		headers:  headers,
+		token:    config.String("auth.token"),
+		username: config.String("auth.username"),
+		password: config.String("auth.password"),
+		attempts: attempts,
+		backoff:  config.Duration("retry.backoff", generator.config.Duration(PathConfigDefaultRestRetryBackoff, DefaultConfigRestRetryBackoff))}, nil
 }
 
-func (configRestClientGenerator) Create() (ConfigRestClient, error) {
-	return &http.Client{}, nil
+func (generator configRestClientGenerator) tlsConfig(
+	config Bag,
+) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.Bool("tls.insecure_skip_verify")}
+
+	caPath := config.String("tls.ca")
+	certPath := config.String("tls.cert")
+	keyPath := config.String("tls.key")
+	if caPath == "" && certPath == "" && keyPath == "" {
+		return tlsConfig, nil
+	}
+
+	disk, e := generator.diskFactory.Get(config.String("tls.disk_id", generator.config.String(PathConfigDefaultFileDiskId)))
+	if e != nil {
+		return nil, e
+	}
+
+	if caPath != "" {
+		ca, e := afero.ReadFile(disk, caPath)
+		if e != nil {
+			return nil, e
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, newErrInvalidResourceConfig("configRestClient", "tls.ca", config)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if certPath != "" || keyPath != "" {
+		cert, e := afero.ReadFile(disk, certPath)
+		if e != nil {
+			return nil, e
+		}
+
+		key, e := afero.ReadFile(disk, keyPath)
+		if e != nil {
+			return nil, e
+		}
+
+		certificate, e := tls.X509KeyPair(cert, key)
+		if e != nil {
+			return nil, e
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
 }
@@ -0,0 +1,164 @@
+package flam
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+)
+
+type ConfigSchemaEntry struct {
+	Path     string
+	Type     ConfigType
+	Required bool
+	Allowed  []any
+	Min      any
+	Max      any
+}
+
+type ConfigSchema []ConfigSchemaEntry
+
+type ConfigViolation struct {
+	Path    string
+	Message string
+}
+
+func (violation ConfigViolation) String() string {
+	return fmt.Sprintf("%s: %s", violation.Path, violation.Message)
+}
+
+func (schema ConfigSchema) Validate(
+	config Config,
+) []ConfigViolation {
+	var violations []ConfigViolation
+	for _, entry := range schema {
+		if message, ok := entry.validate(config.Get(entry.Path)); !ok {
+			violations = append(violations, ConfigViolation{
+				Path:    entry.Path,
+				Message: message})
+		}
+	}
+
+	return violations
+}
+
+func (entry ConfigSchemaEntry) validate(
+	value any,
+) (string, bool) {
+	if value == nil {
+		if entry.Required {
+			return "required value not found", false
+		}
+
+		return "", true
+	}
+
+	if !entry.matchType(value) {
+		return fmt.Sprintf("expected %s, got %T", entry.Type, value), false
+	}
+
+	if len(entry.Allowed) != 0 && !slices.ContainsFunc(entry.Allowed, func(allowed any) bool {
+		return reflect.DeepEqual(allowed, value)
+	}) {
+		return fmt.Sprintf("value %v not in %v", value, entry.Allowed), false
+	}
+
+	measure, ok := entry.measure(value)
+	if !ok {
+		return "", true
+	}
+
+	if limit, ok := entry.measure(entry.Min); ok && measure < limit {
+		return fmt.Sprintf("value %v lower than %v", value, entry.Min), false
+	}
+
+	if limit, ok := entry.measure(entry.Max); ok && measure > limit {
+		return fmt.Sprintf("value %v greater than %v", value, entry.Max), false
+	}
+
+	return "", true
+}
+
+func (entry ConfigSchemaEntry) matchType(
+	value any,
+) bool {
+	kind := reflect.TypeOf(value).Kind()
+
+	switch entry.Type {
+	case ConfigTypeBool:
+		return kind == reflect.Bool
+	case ConfigTypeInt:
+		return isIntKind(kind)
+	case ConfigTypeFloat:
+		return isIntKind(kind) || kind == reflect.Float32 || kind == reflect.Float64
+	case ConfigTypeString:
+		return kind == reflect.String
+	case ConfigTypeDuration:
+		switch value.(type) {
+		case int, int64, time.Duration:
+			return true
+		default:
+			return false
+		}
+	case ConfigTypeList:
+		return kind == reflect.Slice
+	case ConfigTypeBag:
+		_, ok := asBag(value)
+		return ok
+	default:
+		return true
+	}
+}
+
+func (entry ConfigSchemaEntry) measure(
+	value any,
+) (float64, bool) {
+	if value == nil {
+		return 0, false
+	}
+
+	switch entry.Type {
+	case ConfigTypeDuration:
+		switch tval := value.(type) {
+		case int:
+			return float64(time.Duration(tval) * time.Millisecond), true
+		case int64:
+			return float64(time.Duration(tval) * time.Millisecond), true
+		case time.Duration:
+			return float64(tval), true
+		}
+	case ConfigTypeInt, ConfigTypeFloat:
+		val := reflect.ValueOf(value)
+		switch {
+		case val.CanInt():
+			return float64(val.Int()), true
+		case val.CanUint():
+			return float64(val.Uint()), true
+		case val.CanFloat():
+			return val.Float(), true
+		}
+	case ConfigTypeString, ConfigTypeList:
+		val := reflect.ValueOf(value)
+		switch val.Kind() {
+		case reflect.String, reflect.Slice:
+			return float64(val.Len()), true
+		}
+		if number, ok := value.(int); ok {
+			return float64(number), true
+		}
+	}
+
+	return 0, false
+}
+
+func isIntKind(
+	kind reflect.Kind,
+) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,73 @@
+package flam
+
+import (
+	"strings"
+)
+
+type ConfigSecretResolver interface {
+	Accept(driver string) bool
+	Resolve(reference string) (string, error)
+}
+
+func resolveConfigSecrets(
+	value any,
+	path string,
+	resolvers []ConfigSecretResolver,
+	resolved map[string]struct{},
+) (any, error) {
+	switch typedValue := value.(type) {
+	case string:
+		reference, ok := strings.CutPrefix(typedValue, ConfigSecretScheme)
+		if !ok {
+			return value, nil
+		}
+
+		driver, reference, _ := strings.Cut(reference, "/")
+		for _, resolver := range resolvers {
+			if resolver.Accept(driver) {
+				secret, e := resolver.Resolve(reference)
+				if e != nil {
+					return nil, e
+				}
+
+				resolved[path] = struct{}{}
+
+				return secret, nil
+			}
+		}
+
+		return nil, newErrUnknownResource("ConfigSecretResolver", driver)
+	case []any:
+		var result []any
+		for _, i := range typedValue {
+			item, e := resolveConfigSecrets(i, path, resolvers, resolved)
+			if e != nil {
+				return nil, e
+			}
+			result = append(result, item)
+		}
+
+		return result, nil
+	default:
+		bag, ok := asBag(typedValue)
+		if !ok {
+			return value, nil
+		}
+
+		result := Bag{}
+		for key, i := range bag {
+			itemPath := key
+			if path != "" {
+				itemPath = path + "." + key
+			}
+
+			item, e := resolveConfigSecrets(i, itemPath, resolvers, resolved)
+			if e != nil {
+				return nil, e
+			}
+			result[key] = item
+		}
+
+		return result, nil
+	}
+}
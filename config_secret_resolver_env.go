@@ -0,0 +1,28 @@
+package flam
+
+import "os"
+
+type envConfigSecretResolver struct{}
+
+var _ ConfigSecretResolver = (*envConfigSecretResolver)(nil)
+
+func newEnvConfigSecretResolver() ConfigSecretResolver {
+	return &envConfigSecretResolver{}
+}
+
+func (resolver envConfigSecretResolver) Accept(
+	driver string,
+) bool {
+	return driver == ConfigSecretDriverEnv
+}
+
+func (resolver envConfigSecretResolver) Resolve(
+	reference string,
+) (string, error) {
+	secret, ok := os.LookupEnv(reference)
+	if !ok {
+		return "", newErrSecretNotFound(ConfigSecretDriverEnv, reference)
+	}
+
+	return secret, nil
+}
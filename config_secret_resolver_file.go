@@ -0,0 +1,51 @@
+package flam
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+
+	"github.com/spf13/afero"
+)
+
+type fileConfigSecretResolver struct {
+	config      Config
+	diskFactory DiskFactory
+}
+
+var _ ConfigSecretResolver = (*fileConfigSecretResolver)(nil)
+
+func newFileConfigSecretResolver(
+	config Config,
+	diskFactory DiskFactory,
+) ConfigSecretResolver {
+	return &fileConfigSecretResolver{
+		config:      config,
+		diskFactory: diskFactory}
+}
+
+func (resolver fileConfigSecretResolver) Accept(
+	driver string,
+) bool {
+	return driver == ConfigSecretDriverFile
+}
+
+func (resolver fileConfigSecretResolver) Resolve(
+	reference string,
+) (string, error) {
+	disk, e := resolver.diskFactory.Get(resolver.config.String(PathConfigSecretDiskId, DefaultConfigSecretDiskId))
+	if e != nil {
+		return "", e
+	}
+
+	content, e := afero.ReadFile(disk, "/"+reference)
+	if e != nil {
+		if errors.Is(e, fs.ErrNotExist) {
+			return "", newErrSecretNotFound(ConfigSecretDriverFile, reference)
+		}
+
+		return "", e
+	}
+
+	return strings.TrimRight(string(content), "\r\n"), nil
+}
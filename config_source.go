@@ -19,6 +19,12 @@ type ObservableConfigSource interface {
 	Reload() (bool, error)
 }
 
+type notifyingConfigSource interface {
+	ObservableConfigSource
+
+	notify(callback func())
+}
+
 type configSource struct {
 	mu       sync.Mutex
 	bag      Bag
@@ -0,0 +1,151 @@
+package flam
+
+import (
+	"database/sql"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type databaseConfigRecord struct {
+	Path      string `gorm:"primaryKey;size:255"`
+	Value     string
+	Type      string    `gorm:"size:32"`
+	UpdatedAt time.Time `gorm:"index"`
+}
+
+func MigrateDatabaseConfigSource(
+	connection DatabaseConnection,
+	table string,
+) error {
+	return connection.Table(table).AutoMigrate(&databaseConfigRecord{})
+}
+
+type databaseConfigSource struct {
+	configSource
+
+	connection DatabaseConnection
+	table      string
+	updatedAt  string
+	count      int64
+}
+
+var _ ConfigSource = (*databaseConfigSource)(nil)
+var _ ObservableConfigSource = (*databaseConfigSource)(nil)
+
+func newDatabaseConfigSource(
+	priority int,
+	connection DatabaseConnection,
+	table string,
+) (ObservableConfigSource, error) {
+	source := &databaseConfigSource{
+		configSource: configSource{
+			mu:       sync.Mutex{},
+			bag:      Bag{},
+			priority: priority},
+		connection: connection,
+		table:      table}
+
+	if _, e := source.Reload(); e != nil {
+		return nil, e
+	}
+
+	return source, nil
+}
+
+func (source *databaseConfigSource) Reload() (bool, error) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	updatedAt, count, e := source.mark()
+	switch {
+	case e != nil:
+		return false, e
+	case count == source.count && updatedAt == source.updatedAt:
+		return false, nil
+	}
+
+	bag, e := source.load()
+	if e != nil {
+		return false, e
+	}
+
+	source.updatedAt = updatedAt
+	source.count = count
+
+	if reflect.DeepEqual(source.bag, bag) {
+		return false, nil
+	}
+	source.bag = bag
+
+	return true, nil
+}
+
+func (source *databaseConfigSource) mark() (string, int64, error) {
+	var mark struct {
+		Count     int64
+		UpdatedAt sql.NullString
+	}
+
+	if e := source.connection.Table(source.table).Select("COUNT(*) AS count, MAX(updated_at) AS updated_at").Scan(&mark).Error; e != nil {
+		return "", 0, e
+	}
+
+	return mark.UpdatedAt.String, mark.Count, nil
+}
+
+func (source *databaseConfigSource) load() (Bag, error) {
+	var records []databaseConfigRecord
+	if e := source.connection.Table(source.table).Order("path").Find(&records).Error; e != nil {
+		return nil, e
+	}
+
+	bag := Bag{}
+	for _, record := range records {
+		value, e := source.value(record)
+		if e != nil {
+			return nil, e
+		}
+
+		if e := bag.Set(record.Path, value); e != nil {
+			return nil, e
+		}
+	}
+
+	return bag, nil
+}
+
+func (source *databaseConfigSource) value(
+	record databaseConfigRecord,
+) (any, error) {
+	var value any
+	var e error
+
+	switch record.Type {
+	case "", ConfigDatabaseTypeString:
+		return record.Value, nil
+	case ConfigDatabaseTypeInt:
+		value, e = strconv.Atoi(record.Value)
+	case ConfigDatabaseTypeFloat:
+		value, e = strconv.ParseFloat(record.Value, 64)
+	case ConfigDatabaseTypeBool:
+		value, e = strconv.ParseBool(record.Value)
+	case ConfigDatabaseTypeDuration:
+		value, e = time.ParseDuration(record.Value)
+	case ConfigDatabaseTypeJson:
+		var data any
+		if e = json.Unmarshal([]byte(record.Value), &data); e == nil {
+			value = BagNormalization(data)
+		}
+	default:
+		return nil, newErrInvalidDatabaseConfigSourceValue(record.Path, record.Type, record.Value)
+	}
+
+	if e != nil {
+		return nil, newErrInvalidDatabaseConfigSourceValue(record.Path, record.Type, record.Value)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,54 @@
+package flam
+
+type databaseConfigSourceCreator struct {
+	config                    Config
+	databaseConnectionFactory DatabaseConnectionFactory
+}
+
+var _ ConfigSourceCreator = (*databaseConfigSourceCreator)(nil)
+
+func newDatabaseConfigSourceCreator(
+	config Config,
+	databaseConnectionFactory DatabaseConnectionFactory,
+) ConfigSourceCreator {
+	return &databaseConfigSourceCreator{
+		config:                    config,
+		databaseConnectionFactory: databaseConnectionFactory}
+}
+
+func (creator databaseConfigSourceCreator) Accept(
+	config Bag,
+) bool {
+	return config.String("driver") == ConfigSourceDriverDatabase
+}
+
+func (creator databaseConfigSourceCreator) Create(
+	config Bag,
+) (ConfigSource, error) {
+	priority := config.Int("priority", creator.config.Int(PathConfigDefaultPriority))
+	connectionId := config.String("connection_id")
+	table := config.String("table", creator.config.String(PathConfigDefaultDatabaseTable, DefaultConfigDatabaseTable))
+
+	switch {
+	case connectionId == "":
+		return nil, newErrInvalidResourceConfig("databaseConfigSource", "connection_id", config)
+	case table == "":
+		return nil, newErrInvalidResourceConfig("databaseConfigSource", "table", config)
+	}
+
+	connection, e := creator.databaseConnectionFactory.Get(connectionId)
+	if e != nil {
+		return nil, e
+	}
+
+	if config.Bool("migrate") {
+		if e := MigrateDatabaseConfigSource(connection, table); e != nil {
+			return nil, e
+		}
+	}
+
+	return newDatabaseConfigSource(
+		priority,
+		connection,
+		table)
+}
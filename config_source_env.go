@@ -11,8 +11,11 @@ import (
 type envConfigSource struct {
 	configSource
 
-	files    []string
-	mappings map[string]string
+	files     []string
+	mappings  map[string]string
+	prefix    string
+	separator string
+	coerce    bool
 }
 
 var _ ConfigSource = (*envConfigSource)(nil)
@@ -21,14 +24,20 @@ func newEnvConfigSource(
 	priority int,
 	files []string,
 	mappings map[string]string,
+	prefix string,
+	separator string,
+	coerce bool,
 ) (ConfigSource, error) {
 	source := &envConfigSource{
 		configSource: configSource{
 			mu:       sync.Mutex{},
 			bag:      Bag{},
 			priority: priority},
-		files:    files,
-		mappings: mappings}
+		files:     files,
+		mappings:  mappings,
+		prefix:    prefix,
+		separator: separator,
+		coerce:    coerce}
 
 	if e := source.load(); e != nil {
 		return nil, e
@@ -44,25 +53,50 @@ func (source *envConfigSource) load() error {
 		}
 	}
 
+	if source.prefix != "" && source.separator != "" {
+		for _, entry := range os.Environ() {
+			key, env, _ := strings.Cut(entry, "=")
+			if env == "" || !strings.HasPrefix(key, source.prefix+source.separator) {
+				continue
+			}
+
+			var sections []string
+			for _, section := range strings.Split(strings.TrimPrefix(key, source.prefix+source.separator), source.separator) {
+				if section != "" {
+					sections = append(sections, section)
+				}
+			}
+			if len(sections) == 0 {
+				continue
+			}
+
+			path := strings.ToLower(strings.Join(sections, "."))
+			if e := source.bag.Set(path, source.value(env)); e != nil {
+				return e
+			}
+		}
+	}
+
 	for key, path := range source.mappings {
 		env := os.Getenv(key)
 		if env == "" {
 			continue
 		}
 
-		step := source.bag
-		sections := strings.Split(path, ".")
-		for i, section := range sections {
-			if i != len(sections)-1 {
-				if _, ok := step[section]; !ok {
-					step[section] = Bag{}
-				}
-				step = step[section].(Bag)
-			} else {
-				step[section] = env
-			}
+		if e := source.bag.Set(path, source.value(env)); e != nil {
+			return e
 		}
 	}
 
 	return nil
 }
+
+func (source *envConfigSource) value(
+	env string,
+) any {
+	if !source.coerce {
+		return env
+	}
+
+	return coerceConfigValue(env, true)
+}
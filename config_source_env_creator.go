@@ -33,5 +33,8 @@ func (creator envConfigSourceCreator) Create(
 	return newEnvConfigSource(
 		priority,
 		files,
-		mappings)
+		mappings,
+		config.String("prefix"),
+		config.String("separator", creator.config.String(PathConfigDefaultEnvSeparator)),
+		config.Bool("coerce"))
 }
@@ -19,7 +19,7 @@ type ConfigSourceFactory interface {
 	RemoveAll() error
 
 	SetPriority(id string, priority int) error
-	Reload() error
+	Reload(ids ...string) error
 }
 
 type configSources []ConfigSource
@@ -43,8 +43,11 @@ func (container configSources) Less(
 }
 
 type configSourceFactory struct {
-	factory *factory[ConfigSource]
-	config  *config
+	factory         *factory[ConfigSource]
+	secretResolvers []ConfigSecretResolver
+	redactor        *configRedactor
+	config          *config
+	pubSub          PubSub[string, string]
 }
 
 var _ ConfigSourceFactory = (*configSourceFactory)(nil)
@@ -52,9 +55,12 @@ var _ ConfigSourceFactory = (*configSourceFactory)(nil)
 type configSourceFactoryArgs struct {
 	dig.In
 
-	Creators      []ConfigSourceCreator `group:"flam.config.sources.creator"`
-	FactoryConfig FactoryConfig
-	Config        *config
+	Creators        []ConfigSourceCreator  `group:"flam.config.sources.creator"`
+	SecretResolvers []ConfigSecretResolver `group:"flam.config.secrets.resolver"`
+	FactoryConfig   FactoryConfig
+	PubSub          PubSub[string, string]
+	Redactor        *configRedactor
+	Config          *config
 }
 
 func newConfigSourceFactory(
@@ -69,11 +75,15 @@ func newConfigSourceFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("ConfigSource"),
-		PathConfigSources)
+		PathConfigSources,
+		args.PubSub)
 
 	return &configSourceFactory{
-		factory: f.(*factory[ConfigSource]),
-		config:  args.Config}, nil
+		factory:         f.(*factory[ConfigSource]),
+		secretResolvers: args.SecretResolvers,
+		redactor:        args.Redactor,
+		config:          args.Config,
+		pubSub:          args.PubSub}, nil
 }
 
 func (factory configSourceFactory) Close() error {
@@ -97,9 +107,20 @@ func (factory configSourceFactory) Has(
 func (factory configSourceFactory) Get(
 	id string,
 ) (ConfigSource, error) {
+	factory.factory.locker.Lock()
+	_, stored := factory.factory.entries[id]
+	factory.factory.locker.Unlock()
+
 	source, e := factory.factory.Get(id)
-	if e == nil {
-		factory.reload()
+	if e != nil {
+		return nil, e
+	}
+
+	e = factory.reload()
+
+	if !stored {
+		factory.notify(id, source)
+		publish(factory.pubSub, EventConfigSourceAdded, id)
 	}
 
 	return source, e
@@ -109,39 +130,67 @@ func (factory configSourceFactory) Store(
 	id string,
 	value ConfigSource,
 ) error {
-	e := factory.factory.Store(id, value)
-	if e == nil {
-		factory.reload()
+	if e := factory.factory.Store(id, value); e != nil {
+		return e
 	}
 
+	e := factory.reload()
+
+	factory.notify(id, value)
+	publish(factory.pubSub, EventConfigSourceAdded, id)
+
 	return e
 }
 
 func (factory configSourceFactory) Remove(
 	id string,
 ) error {
-	e := factory.factory.Remove(id)
-	if e == nil {
-		factory.reload()
+	if e := factory.factory.Remove(id); e != nil {
+		return e
 	}
 
+	e := factory.reload()
+
+	publish(factory.pubSub, EventConfigSourceRemoved, id)
+
 	return e
 }
 
 func (factory configSourceFactory) RemoveAll() error {
-	e := factory.factory.RemoveAll()
-	if e == nil {
-		factory.reload()
+	ids := factory.factory.Stored()
+
+	if e := factory.factory.RemoveAll(); e != nil {
+		return e
+	}
+
+	e := factory.reload()
+
+	for _, id := range ids {
+		publish(factory.pubSub, EventConfigSourceRemoved, id)
 	}
 
 	return e
 }
 
-func (factory configSourceFactory) Reload() error {
+func (factory configSourceFactory) Reload(
+	ids ...string,
+) error {
 	factory.factory.locker.Lock()
 
-	reloaded := false
-	for _, source := range factory.factory.entries {
+	if len(ids) == 0 {
+		for id := range factory.factory.entries {
+			ids = append(ids, id)
+		}
+	}
+
+	var reloaded []string
+	for _, id := range ids {
+		source, ok := factory.factory.entries[id]
+		if !ok {
+			factory.factory.locker.Unlock()
+			return newErrUnknownResource("ConfigSource", id)
+		}
+
 		if observable, ok := source.(ObservableConfigSource); ok {
 			updated, e := observable.Reload()
 			if e != nil {
@@ -149,13 +198,24 @@ func (factory configSourceFactory) Reload() error {
 				return e
 			}
 
-			reloaded = reloaded || updated
+			if updated {
+				reloaded = append(reloaded, id)
+			}
 		}
 	}
 	factory.factory.locker.Unlock()
 
-	if reloaded {
-		factory.reload()
+	if len(reloaded) == 0 {
+		return nil
+	}
+
+	if e := factory.reload(); e != nil {
+		return e
+	}
+
+	sort.Strings(reloaded)
+	for _, id := range reloaded {
+		publish(factory.pubSub, EventConfigSourceReloaded, id)
 	}
 
 	return nil
@@ -174,12 +234,22 @@ func (factory configSourceFactory) SetPriority(
 	source.SetPriority(priority)
 	factory.factory.locker.Unlock()
 
-	factory.reload()
+	return factory.reload()
+}
 
-	return nil
+func (factory configSourceFactory) notify(
+	id string,
+	source ConfigSource,
+) {
+	if notifying, ok := source.(notifyingConfigSource); ok {
+		notifying.notify(func() {
+			// Error ignored - a failed reload keeps the previous source config, as the polling does
+			_ = factory.Reload(id)
+		})
+	}
 }
 
-func (factory configSourceFactory) reload() {
+func (factory configSourceFactory) reload() error {
 	factory.factory.locker.Lock()
 	defer factory.factory.locker.Unlock()
 
@@ -197,9 +267,35 @@ func (factory configSourceFactory) reload() {
 		}
 	}
 
+	sensitive := map[string]struct{}{}
+	resolved, e := factory.resolve(data, sensitive)
+	if e != nil {
+		return e
+	}
+
 	factory.config.mu.Lock()
-	factory.config.sourcesBag = data
+	factory.config.sourcesBag = resolved
+	factory.config.rebuild()
 	factory.config.mu.Unlock()
 
-	factory.config.rebuild()
+	factory.redactor.update(configRedactKeys(factory.config), sensitive, configRedactValues(resolved, sensitive))
+
+	return nil
+}
+
+func (factory configSourceFactory) resolve(
+	data Bag,
+	sensitive map[string]struct{},
+) (Bag, error) {
+	interpolated, e := interpolateConfig(data)
+	if e != nil {
+		return nil, e
+	}
+
+	resolved, e := resolveConfigSecrets(interpolated, "", factory.secretResolvers, sensitive)
+	if e != nil {
+		return nil, e
+	}
+
+	return decryptConfig(resolved.(Bag), sensitive)
 }
@@ -0,0 +1,97 @@
+package flam
+
+import (
+	"strings"
+	"sync"
+)
+
+type flagsConfigSource struct {
+	configSource
+
+	args   []string
+	coerce bool
+}
+
+var _ ConfigSource = (*flagsConfigSource)(nil)
+
+func newFlagsConfigSource(
+	priority int,
+	args []string,
+	coerce bool,
+) (ConfigSource, error) {
+	source := &flagsConfigSource{
+		configSource: configSource{
+			mu:       sync.Mutex{},
+			bag:      Bag{},
+			priority: priority},
+		args:   args,
+		coerce: coerce}
+
+	if e := source.load(); e != nil {
+		return nil, e
+	}
+
+	return source, nil
+}
+
+func (source *flagsConfigSource) load() error {
+	var paths []string
+	values := map[string][]any{}
+
+	for i := 0; i < len(source.args); i++ {
+		arg := source.args[i]
+		if arg == "--" {
+			break
+		}
+
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		path, raw, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		path = strings.ToLower(path)
+		if path == "" {
+			continue
+		}
+
+		if !ok && i+1 < len(source.args) && !strings.HasPrefix(source.args[i+1], "--") {
+			i++
+			raw, ok = source.args[i], true
+		}
+
+		var value any = true
+		if ok {
+			value = raw
+			if source.coerce {
+				value = coerceConfigValue(raw, true)
+			}
+		}
+
+		if _, ok := values[path]; !ok {
+			paths = append(paths, path)
+		}
+
+		values[path] = append(values[path], value)
+	}
+
+	for _, path := range paths {
+		var value any = values[path][0]
+		if len(values[path]) > 1 {
+			var items []any
+			for _, occurrence := range values[path] {
+				if list, ok := occurrence.([]any); ok {
+					items = append(items, list...)
+				} else {
+					items = append(items, occurrence)
+				}
+			}
+			value = items
+		}
+
+		if e := source.bag.Set(path, value); e != nil {
+			return e
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package flam
+
+import (
+	"os"
+)
+
+type flagsConfigSourceCreator struct {
+	config Config
+}
+
+var _ ConfigSourceCreator = (*flagsConfigSourceCreator)(nil)
+
+func newFlagsConfigSourceCreator(config Config) ConfigSourceCreator {
+	return &flagsConfigSourceCreator{
+		config: config}
+}
+
+func (creator flagsConfigSourceCreator) Accept(
+	config Bag,
+) bool {
+	return config.String("driver") == ConfigSourceDriverFlags
+}
+
+func (creator flagsConfigSourceCreator) Create(
+	config Bag,
+) (ConfigSource, error) {
+	priority := config.Int("priority", creator.config.Int(PathConfigDefaultPriority))
+	args := config.StringSlice("args")
+	if config.Bool("os_args") {
+		args = os.Args[1:]
+	}
+
+	return newFlagsConfigSource(
+		priority,
+		args,
+		config.Bool("coerce"))
+}
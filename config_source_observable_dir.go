@@ -0,0 +1,131 @@
+package flam
+
+import (
+	"maps"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+type observableDirConfigSource struct {
+	dirConfigSource
+
+	timestamps map[string]time.Time
+}
+
+var _ ConfigSource = (*observableDirConfigSource)(nil)
+var _ ObservableConfigSource = (*observableDirConfigSource)(nil)
+var _ watchableConfigSource = (*observableDirConfigSource)(nil)
+
+func newObservableDirConfigSource(
+	priority int,
+	disk Disk,
+	path string,
+	configParser ConfigParser,
+	recursive bool,
+) (ObservableConfigSource, error) {
+	source := &observableDirConfigSource{
+		dirConfigSource: dirConfigSource{
+			configSource: configSource{
+				mu:       sync.Mutex{},
+				bag:      Bag{},
+				priority: priority},
+			disk:         disk,
+			path:         path,
+			configParser: configParser,
+			recursive:    recursive}}
+
+	if _, e := source.Reload(); e != nil {
+		return nil, e
+	}
+
+	return source, nil
+}
+
+func (source *observableDirConfigSource) Reload() (bool, error) {
+	timestamps := map[string]time.Time{}
+	if e := source.scan(source.path, timestamps); e != nil {
+		return false, e
+	}
+
+	if source.timestamps != nil && maps.EqualFunc(source.timestamps, timestamps, time.Time.Equal) {
+		return false, nil
+	}
+
+	if e := source.load(); e != nil {
+		return false, e
+	}
+	source.timestamps = timestamps
+
+	return true, nil
+}
+
+func (source *observableDirConfigSource) scan(
+	path string,
+	timestamps map[string]time.Time,
+) error {
+	dir, e := source.disk.Open(path)
+	if e != nil {
+		return e
+	}
+	defer func() { _ = dir.Close() }()
+
+	files, e := dir.Readdir(0)
+	if e != nil {
+		return e
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			if source.recursive {
+				if e := source.scan(path+"/"+file.Name(), timestamps); e != nil {
+					return e
+				}
+			}
+		} else {
+			timestamps[path+"/"+file.Name()] = file.ModTime()
+		}
+	}
+
+	return nil
+}
+
+func (source *observableDirConfigSource) watchTargets() ([]configWatchTarget, error) {
+	if !isOsDisk(source.disk) {
+		return nil, nil
+	}
+
+	return source.watchDir(filepath.Clean(source.path))
+}
+
+func (source *observableDirConfigSource) watchDir(
+	path string,
+) ([]configWatchTarget, error) {
+	targets := []configWatchTarget{{dir: path}}
+	if !source.recursive {
+		return targets, nil
+	}
+
+	dir, e := source.disk.Open(path)
+	if e != nil {
+		return nil, e
+	}
+	defer func() { _ = dir.Close() }()
+
+	files, e := dir.Readdir(0)
+	if e != nil {
+		return nil, e
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			partial, e := source.watchDir(filepath.Join(path, file.Name()))
+			if e != nil {
+				return nil, e
+			}
+			targets = append(targets, partial...)
+		}
+	}
+
+	return targets, nil
+}
@@ -0,0 +1,61 @@
+package flam
+
+type observableDirConfigSourceCreator struct {
+	dirConfigSourceCreator
+}
+
+var _ ConfigSourceCreator = (*observableDirConfigSourceCreator)(nil)
+
+func newObservableDirConfigSourceCreator(
+	config Config,
+	diskFactory DiskFactory,
+	configParserFactory ConfigParserFactory,
+) ConfigSourceCreator {
+	return &observableDirConfigSourceCreator{
+		dirConfigSourceCreator: dirConfigSourceCreator{
+			config:              config,
+			diskFactory:         diskFactory,
+			configParserFactory: configParserFactory}}
+}
+
+func (creator observableDirConfigSourceCreator) Accept(
+	config Bag,
+) bool {
+	return config.String("driver") == ConfigSourceDriverObservableDir
+}
+
+func (creator observableDirConfigSourceCreator) Create(
+	config Bag,
+) (ConfigSource, error) {
+	priority := config.Int("priority", creator.config.Int(PathConfigDefaultPriority))
+	diskId := config.String("disk_id", creator.config.String(PathConfigDefaultFileDiskId))
+	path := config.String("path")
+	parserId := config.String("parser_id", creator.config.String(PathConfigDefaultFileParserId))
+	recursive := config.Bool("recursive")
+
+	switch {
+	case diskId == "":
+		return nil, newErrInvalidResourceConfig("observableDirConfigSource", "disk_id", config)
+	case path == "":
+		return nil, newErrInvalidResourceConfig("observableDirConfigSource", "path", config)
+	case parserId == "":
+		return nil, newErrInvalidResourceConfig("observableDirConfigSource", "parser_id", config)
+	}
+
+	disk, e := creator.diskFactory.Get(diskId)
+	if e != nil {
+		return nil, e
+	}
+
+	parser, e := creator.configParserFactory.Get(parserId)
+	if e != nil {
+		return nil, e
+	}
+
+	return newObservableDirConfigSource(
+		priority,
+		disk,
+		path,
+		parser,
+		recursive)
+}
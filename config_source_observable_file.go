@@ -1,6 +1,7 @@
 package flam
 
 import (
+	"path/filepath"
 	"sync"
 	"time"
 )
@@ -14,6 +15,7 @@ type observableFileConfigSource struct {
 
 var _ ConfigSource = (*observableFileConfigSource)(nil)
 var _ ObservableConfigSource = (*observableFileConfigSource)(nil)
+var _ watchableConfigSource = (*observableFileConfigSource)(nil)
 
 func newObservableFileConfigSource(
 	priority int,
@@ -59,3 +61,15 @@ func (source *observableFileConfigSource) Reload() (bool, error) {
 
 	return false, nil
 }
+
+func (source *observableFileConfigSource) watchTargets() ([]configWatchTarget, error) {
+	if !isOsDisk(source.disk) {
+		return nil, nil
+	}
+
+	path := filepath.Clean(source.path)
+
+	return []configWatchTarget{{
+		dir:  filepath.Dir(path),
+		file: path}}, nil
+}
@@ -1,6 +1,8 @@
 package flam
 
 import (
+	"net/http"
+	"reflect"
 	"sync"
 	"time"
 )
@@ -11,6 +13,9 @@ type observableRestConfigSource struct {
 	timestampPath string
 	timestamp     time.Time
 	timer         Timer
+	conditional   bool
+	etag          string
+	lastModified  string
 }
 
 var _ ConfigSource = (*observableRestConfigSource)(nil)
@@ -23,6 +28,7 @@ func newObservableRestConfigSource(
 	configParser ConfigParser,
 	configPath string,
 	timestampPath string,
+	conditional bool,
 	timer Timer,
 ) (ConfigSource, error) {
 	source := &observableRestConfigSource{
@@ -37,7 +43,8 @@ func newObservableRestConfigSource(
 			configParser:     configParser},
 		timestampPath: timestampPath,
 		timestamp:     timer.Now(),
-		timer:         timer}
+		timer:         timer,
+		conditional:   conditional}
 
 	if _, e := source.Reload(); e != nil {
 		return nil, e
@@ -47,6 +54,10 @@ func newObservableRestConfigSource(
 }
 
 func (source *observableRestConfigSource) Reload() (bool, error) {
+	if source.conditional {
+		return source.reloadConditional()
+	}
+
 	response, e := source.request()
 	if e != nil {
 		return false, e
@@ -74,6 +85,49 @@ func (source *observableRestConfigSource) Reload() (bool, error) {
 	return false, nil
 }
 
+func (source *observableRestConfigSource) reloadConditional() (bool, error) {
+	header := http.Header{}
+	if source.etag != "" {
+		header.Set("If-None-Match", source.etag)
+	}
+	if source.lastModified != "" {
+		header.Set("If-Modified-Since", source.lastModified)
+	}
+
+	response, e := source.send(header)
+	if e != nil {
+		return false, e
+	}
+	defer func() { _ = response.Body.Close() }()
+
+	if response.StatusCode == http.StatusNotModified {
+		return false, nil
+	}
+
+	bag, e := source.parse(response)
+	if e != nil {
+		return false, e
+	}
+
+	if source.configPath != "" {
+		if bag, e = source.getConfig(bag); e != nil {
+			return false, e
+		}
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	source.etag = response.Header.Get("ETag")
+	source.lastModified = response.Header.Get("Last-Modified")
+	if reflect.DeepEqual(source.bag, bag) {
+		return false, nil
+	}
+	source.bag = bag
+
+	return true, nil
+}
+
 func (source *observableRestConfigSource) getTimestamp(
 	response Bag,
 ) (time.Time, error) {
@@ -34,21 +34,30 @@ func (creator observableRestConfigSourceCreator) Create(
 	priority := config.Int("priority", creator.config.Int(PathConfigDefaultPriority))
 	uri := config.String("uri")
 	parserId := config.String("parser_id", creator.config.String(PathConfigDefaultRestParserId))
-	configPath := config.String("path.config", creator.config.String(PathConfigDefaultRestConfigPath))
-	timestampPath := config.String("path.timestamp", creator.config.String(PathConfigDefaultRestTimestampPath))
+	mode := config.String("mode", ConfigSourceRestModeTimestamp)
+	conditional := mode == ConfigSourceRestModeConditional
+
+	configPath := config.String("path.config")
+	timestampPath := ""
+	if !conditional {
+		configPath = config.String("path.config", creator.config.String(PathConfigDefaultRestConfigPath))
+		timestampPath = config.String("path.timestamp", creator.config.String(PathConfigDefaultRestTimestampPath))
+	}
 
 	switch {
 	case uri == "":
 		return nil, newErrInvalidResourceConfig("observableRestConfigSource", "uri", config)
 	case parserId == "":
 		return nil, newErrInvalidResourceConfig("observableRestConfigSource", "parser_id", config)
-	case configPath == "":
+	case mode != ConfigSourceRestModeTimestamp && !conditional:
+		return nil, newErrInvalidResourceConfig("observableRestConfigSource", "mode", config)
+	case !conditional && configPath == "":
 		return nil, newErrInvalidResourceConfig("observableRestConfigSource", "config_path", config)
-	case timestampPath == "":
+	case !conditional && timestampPath == "":
 		return nil, newErrInvalidResourceConfig("observableRestConfigSource", "timestamp_path", config)
 	}
 
-	requester, e := creator.configRestClientGenerator.Create()
+	requester, e := creator.configRestClientGenerator.Create(config.Bag("client", Bag{}))
 	if e != nil {
 		return nil, e
 	}
@@ -65,5 +74,6 @@ func (creator observableRestConfigSourceCreator) Create(
 		parser,
 		configPath,
 		timestampPath,
+		conditional,
 		creator.timer)
 }
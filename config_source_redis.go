@@ -0,0 +1,214 @@
+package flam
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var redisGlobEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"*", `\*`,
+	"?", `\?`,
+	"[", `\[`,
+	"]", `\]`)
+
+type redisConfigSource struct {
+	configSource
+
+	connection RedisConnection
+	key        string
+	prefix     string
+	coerce     bool
+	pubSub     *redis.PubSub
+	callback   func()
+}
+
+var _ ConfigSource = (*redisConfigSource)(nil)
+var _ ObservableConfigSource = (*redisConfigSource)(nil)
+var _ notifyingConfigSource = (*redisConfigSource)(nil)
+
+func newRedisConfigSource(
+	priority int,
+	connection RedisConnection,
+	key string,
+	prefix string,
+	channels []string,
+	patterns []string,
+	coerce bool,
+) (ObservableConfigSource, error) {
+	source := &redisConfigSource{
+		configSource: configSource{
+			mu:       sync.Mutex{},
+			bag:      Bag{},
+			priority: priority},
+		connection: connection,
+		key:        key,
+		prefix:     prefix,
+		coerce:     coerce}
+
+	if _, e := source.Reload(); e != nil {
+		return nil, e
+	}
+
+	if e := source.subscribe(channels, patterns); e != nil {
+		return nil, e
+	}
+
+	return source, nil
+}
+
+func (source *redisConfigSource) Close() error {
+	source.mu.Lock()
+	pubSub := source.pubSub
+	source.pubSub = nil
+	source.mu.Unlock()
+
+	if pubSub != nil {
+		return pubSub.Close()
+	}
+
+	return nil
+}
+
+func (source *redisConfigSource) Reload() (bool, error) {
+	bag, e := source.load()
+	if e != nil {
+		return false, e
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	if reflect.DeepEqual(source.bag, bag) {
+		return false, nil
+	}
+	source.bag = bag
+
+	return true, nil
+}
+
+func (source *redisConfigSource) notify(
+	callback func(),
+) {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	source.callback = callback
+}
+
+func (source *redisConfigSource) load() (Bag, error) {
+	values, e := source.values()
+	if e != nil {
+		return nil, e
+	}
+
+	paths := make([]string, 0, len(values))
+	for path := range values {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	bag := Bag{}
+	for _, path := range paths {
+		var value any = values[path]
+		if source.coerce {
+			value = coerceConfigValue(values[path], true)
+		}
+
+		if e := bag.Set(path, value); e != nil {
+			return nil, e
+		}
+	}
+
+	return bag, nil
+}
+
+func (source *redisConfigSource) values() (map[string]string, error) {
+	ctx := context.Background()
+
+	if source.key != "" {
+		return source.connection.HGetAll(ctx, source.key).Result()
+	}
+
+	values := map[string]string{}
+
+	var cursor uint64
+	for {
+		keys, next, e := source.connection.Scan(ctx, cursor, redisGlobEscaper.Replace(source.prefix)+"*", 100).Result()
+		if e != nil {
+			return nil, e
+		}
+
+		for _, key := range keys {
+			value, e := source.connection.Get(ctx, key).Result()
+			switch {
+			case errors.Is(e, redis.Nil):
+				continue
+			case e != nil:
+				return nil, e
+			}
+
+			values[strings.TrimPrefix(key, source.prefix)] = value
+		}
+
+		if cursor = next; cursor == 0 {
+			return values, nil
+		}
+	}
+}
+
+func (source *redisConfigSource) subscribe(
+	channels []string,
+	patterns []string,
+) error {
+	if len(channels) == 0 && len(patterns) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	pubSub := source.connection.Subscribe(ctx)
+	if len(channels) != 0 {
+		if e := pubSub.Subscribe(ctx, channels...); e != nil {
+			// Error ignored - the subscription error is the one reported
+			_ = pubSub.Close()
+			return e
+		}
+	}
+
+	if len(patterns) != 0 {
+		if e := pubSub.PSubscribe(ctx, patterns...); e != nil {
+			// Error ignored - the subscription error is the one reported
+			_ = pubSub.Close()
+			return e
+		}
+	}
+
+	source.mu.Lock()
+	source.pubSub = pubSub
+	source.mu.Unlock()
+
+	go source.listen(pubSub.Channel())
+
+	return nil
+}
+
+func (source *redisConfigSource) listen(
+	messages <-chan *redis.Message,
+) {
+	for range messages {
+		source.mu.Lock()
+		callback := source.callback
+		source.mu.Unlock()
+
+		if callback != nil {
+			callback()
+		}
+	}
+}
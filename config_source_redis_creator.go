@@ -0,0 +1,80 @@
+package flam
+
+import (
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisConfigSourceCreator struct {
+	config                 Config
+	redisConnectionFactory RedisConnectionFactory
+}
+
+var _ ConfigSourceCreator = (*redisConfigSourceCreator)(nil)
+
+func newRedisConfigSourceCreator(
+	config Config,
+	redisConnectionFactory RedisConnectionFactory,
+) ConfigSourceCreator {
+	return &redisConfigSourceCreator{
+		config:                 config,
+		redisConnectionFactory: redisConnectionFactory}
+}
+
+func (creator redisConfigSourceCreator) Accept(
+	config Bag,
+) bool {
+	return config.String("driver") == ConfigSourceDriverRedis
+}
+
+func (creator redisConfigSourceCreator) Create(
+	config Bag,
+) (ConfigSource, error) {
+	priority := config.Int("priority", creator.config.Int(PathConfigDefaultPriority))
+	connectionId := config.String("connection_id")
+	key := config.String("key")
+	prefix := config.String("prefix")
+
+	switch {
+	case connectionId == "":
+		return nil, newErrInvalidResourceConfig("redisConfigSource", "connection_id", config)
+	case key == "" && prefix == "":
+		return nil, newErrInvalidResourceConfig("redisConfigSource", "key", config)
+	case key != "" && prefix != "":
+		return nil, newErrInvalidResourceConfig("redisConfigSource", "prefix", config)
+	}
+
+	connection, e := creator.redisConnectionFactory.Get(connectionId)
+	if e != nil {
+		return nil, e
+	}
+
+	var channels []string
+	if channel := config.String("channel"); channel != "" {
+		channels = append(channels, channel)
+	}
+
+	var patterns []string
+	if config.Bool("keyspace") {
+		db := 0
+		if client, ok := connection.(interface{ Options() *redis.Options }); ok {
+			db = client.Options().DB
+		}
+
+		if key != "" {
+			patterns = append(patterns, fmt.Sprintf(ConfigRedisKeyspaceChannel, db, redisGlobEscaper.Replace(key)))
+		} else {
+			patterns = append(patterns, fmt.Sprintf(ConfigRedisKeyspaceChannel, db, redisGlobEscaper.Replace(prefix)+"*"))
+		}
+	}
+
+	return newRedisConfigSource(
+		priority,
+		connection,
+		key,
+		prefix,
+		channels,
+		patterns,
+		config.Bool("coerce"))
+}
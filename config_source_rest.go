@@ -1,6 +1,7 @@
 package flam
 
 import (
+	"io"
 	"net/http"
 	"sync"
 )
@@ -59,15 +60,36 @@ func (source *restConfigSource) load() error {
 }
 
 func (source *restConfigSource) request() (Bag, error) {
-	request, e := http.NewRequest(http.MethodGet, source.uri, http.NoBody)
+	response, e := source.send(http.Header{})
 	if e != nil {
 		return nil, e
 	}
+	defer func() { _ = response.Body.Close() }()
+
+	return source.parse(response)
+}
 
-	response, e := source.configRestClient.Do(request)
+func (source *restConfigSource) send(
+	header http.Header,
+) (*http.Response, error) {
+	request, e := http.NewRequest(http.MethodGet, source.uri, http.NoBody)
 	if e != nil {
 		return nil, e
 	}
+	request.Header = header
+
+	return source.configRestClient.Do(request)
+}
+
+func (source *restConfigSource) parse(
+	response *http.Response,
+) (Bag, error) {
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		// Error ignored - the body is only used to describe the status error
+		body, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+
+		return nil, newErrRestConfigSourceStatus(source.uri, response.StatusCode, string(body))
+	}
 
 	return source.configParser.Parse(response.Body)
 }
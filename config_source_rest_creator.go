@@ -42,7 +42,7 @@ func (creator restConfigSourceCreator) Create(
 		return nil, newErrInvalidResourceConfig("restConfigSource", "config_path", config)
 	}
 
-	requester, e := creator.configRestClientGenerator.Create()
+	requester, e := creator.configRestClientGenerator.Create(config.Bag("client", Bag{}))
 	if e != nil {
 		return nil, e
 	}
@@ -0,0 +1,35 @@
+package flam
+
+type ConfigType int
+
+const (
+	ConfigTypeAny ConfigType = iota
+	ConfigTypeBool
+	ConfigTypeInt
+	ConfigTypeFloat
+	ConfigTypeString
+	ConfigTypeDuration
+	ConfigTypeList
+	ConfigTypeBag
+)
+
+func (configType ConfigType) String() string {
+	switch configType {
+	case ConfigTypeBool:
+		return "bool"
+	case ConfigTypeInt:
+		return "int"
+	case ConfigTypeFloat:
+		return "float"
+	case ConfigTypeString:
+		return "string"
+	case ConfigTypeDuration:
+		return "duration"
+	case ConfigTypeList:
+		return "list"
+	case ConfigTypeBag:
+		return "bag"
+	default:
+		return "any"
+	}
+}
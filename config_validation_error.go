@@ -0,0 +1,22 @@
+package flam
+
+import "strings"
+
+type ConfigValidationError struct {
+	Violations []ConfigViolation
+}
+
+var _ error = (*ConfigValidationError)(nil)
+
+func (e *ConfigValidationError) Error() string {
+	var messages []string
+	for _, violation := range e.Violations {
+		messages = append(messages, violation.String())
+	}
+
+	return ErrInvalidConfig.Error() + ": " + strings.Join(messages, ", ")
+}
+
+func (e *ConfigValidationError) Unwrap() error {
+	return ErrInvalidConfig
+}
@@ -0,0 +1,37 @@
+package flam
+
+import "sync"
+
+type configValidator struct {
+	mu     sync.Mutex
+	config Config
+	schema ConfigSchema
+}
+
+func newConfigValidator(
+	config Config,
+) *configValidator {
+	return &configValidator{
+		config: config}
+}
+
+func (validator *configValidator) set(
+	schema ConfigSchema,
+) {
+	validator.mu.Lock()
+	defer validator.mu.Unlock()
+
+	validator.schema = schema
+}
+
+func (validator *configValidator) Validate() error {
+	validator.mu.Lock()
+	schema := validator.schema
+	validator.mu.Unlock()
+
+	if violations := schema.Validate(validator.config); len(violations) != 0 {
+		return newErrInvalidConfig(violations)
+	}
+
+	return nil
+}
@@ -0,0 +1,291 @@
+package flam
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+type configWatchTarget struct {
+	dir  string
+	file string
+}
+
+func (target configWatchTarget) match(
+	path string,
+) bool {
+	if target.file != "" {
+		return path == target.file
+	}
+
+	return path == target.dir || filepath.Dir(path) == target.dir
+}
+
+type watchableConfigSource interface {
+	ObservableConfigSource
+
+	watchTargets() ([]configWatchTarget, error)
+}
+
+type configWatcher struct {
+	mu                  sync.Mutex
+	config              Config
+	configSourceFactory ConfigSourceFactory
+	pubSub              PubSub[string, string]
+	watcher             *fsnotify.Watcher
+	debounce            time.Duration
+	targets             map[string][]configWatchTarget
+	timers              map[string]*time.Timer
+}
+
+func newConfigWatcher(
+	config Config,
+	configSourceFactory ConfigSourceFactory,
+	pubSub PubSub[string, string],
+) *configWatcher {
+	return &configWatcher{
+		config:              config,
+		configSourceFactory: configSourceFactory,
+		pubSub:              pubSub,
+		targets:             map[string][]configWatchTarget{},
+		timers:              map[string]*time.Timer{}}
+}
+
+func (watcher *configWatcher) Close() error {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if watcher.watcher == nil {
+		return nil
+	}
+
+	// Errors ignored - unsubscription is cleanup, shouldn't block watcher close
+	_ = watcher.pubSub.Unsubscribe("flam.config.watcher", EventConfigSourceAdded)
+	_ = watcher.pubSub.Unsubscribe("flam.config.watcher", EventConfigSourceRemoved)
+
+	for id, timer := range watcher.timers {
+		timer.Stop()
+		delete(watcher.timers, id)
+	}
+	clear(watcher.targets)
+
+	w := watcher.watcher
+	watcher.watcher = nil
+
+	return w.Close()
+}
+
+func (watcher *configWatcher) Boot() error {
+	if !watcher.config.Bool(PathConfigBoot) || !watcher.config.Bool(PathConfigWatcherEnabled) {
+		return nil
+	}
+
+	w, e := fsnotify.NewWatcher()
+	if e != nil {
+		return e
+	}
+
+	watcher.mu.Lock()
+	watcher.watcher = w
+	watcher.debounce = watcher.config.Duration(PathConfigWatcherDebounce)
+	watcher.mu.Unlock()
+
+	for _, id := range watcher.configSourceFactory.Stored() {
+		if e := watcher.watch(id); e != nil {
+			return e
+		}
+	}
+
+	if e := watcher.pubSub.Subscribe(
+		"flam.config.watcher",
+		EventConfigSourceAdded,
+		func(_ string, data ...any) error {
+			if id, ok := append(data, nil)[0].(string); ok {
+				return watcher.watch(id)
+			}
+			return nil
+		},
+	); e != nil {
+		return e
+	}
+
+	if e := watcher.pubSub.Subscribe(
+		"flam.config.watcher",
+		EventConfigSourceRemoved,
+		func(_ string, data ...any) error {
+			if id, ok := append(data, nil)[0].(string); ok {
+				watcher.unwatch(id)
+			}
+			return nil
+		},
+	); e != nil {
+		return e
+	}
+
+	go watcher.run(w)
+
+	return nil
+}
+
+func (watcher *configWatcher) Watching() bool {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	return len(watcher.targets) != 0
+}
+
+func (watcher *configWatcher) Unwatched(
+	ids []string,
+) []string {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	var unwatched []string
+	for _, id := range ids {
+		if len(watcher.targets[id]) == 0 {
+			unwatched = append(unwatched, id)
+		}
+	}
+
+	return unwatched
+}
+
+func (watcher *configWatcher) watch(
+	id string,
+) error {
+	source, e := watcher.configSourceFactory.Get(id)
+	if e != nil {
+		return e
+	}
+
+	watchable, ok := source.(watchableConfigSource)
+	if !ok {
+		return nil
+	}
+
+	targets, e := watchable.watchTargets()
+	if e != nil {
+		return e
+	}
+
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if watcher.watcher == nil {
+		return nil
+	}
+
+	for _, target := range targets {
+		if e := watcher.watcher.Add(target.dir); e != nil {
+			return e
+		}
+	}
+
+	previous := watcher.targets[id]
+	watcher.targets[id] = targets
+	watcher.release(previous)
+
+	return nil
+}
+
+func (watcher *configWatcher) unwatch(
+	id string,
+) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	if timer, ok := watcher.timers[id]; ok {
+		timer.Stop()
+		delete(watcher.timers, id)
+	}
+
+	targets := watcher.targets[id]
+	delete(watcher.targets, id)
+
+	if watcher.watcher == nil {
+		return
+	}
+
+	watcher.release(targets)
+}
+
+func (watcher *configWatcher) release(
+	targets []configWatchTarget,
+) {
+	used := map[string]bool{}
+	for _, remaining := range watcher.targets {
+		for _, target := range remaining {
+			used[target.dir] = true
+		}
+	}
+
+	for _, target := range targets {
+		if !used[target.dir] {
+			// Error ignored - the directory may have already been removed from the disk
+			_ = watcher.watcher.Remove(target.dir)
+		}
+	}
+}
+
+func (watcher *configWatcher) run(
+	w *fsnotify.Watcher,
+) {
+	for {
+		select {
+		case event, ok := <-w.Events:
+			if !ok {
+				return
+			}
+			watcher.notify(filepath.Clean(event.Name))
+		case _, ok := <-w.Errors:
+			// Error ignored - the affected sources are still refreshed on the next change
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+func (watcher *configWatcher) notify(
+	path string,
+) {
+	watcher.mu.Lock()
+	defer watcher.mu.Unlock()
+
+	for id, targets := range watcher.targets {
+		for _, target := range targets {
+			if target.match(path) {
+				watcher.schedule(id)
+				break
+			}
+		}
+	}
+}
+
+func (watcher *configWatcher) schedule(
+	id string,
+) {
+	if timer, ok := watcher.timers[id]; ok {
+		timer.Reset(watcher.debounce)
+		return
+	}
+
+	watcher.timers[id] = time.AfterFunc(watcher.debounce, func() {
+		watcher.mu.Lock()
+		delete(watcher.timers, id)
+		_, watched := watcher.targets[id]
+		watcher.mu.Unlock()
+
+		if !watched {
+			return
+		}
+
+		// Error ignored - a failed reload keeps the previous source config, as the polling does
+		_ = watcher.configSourceFactory.Reload(id)
+
+		// Error ignored - the previous targets are kept watched if the refresh fails
+		_ = watcher.watch(id)
+	})
+}
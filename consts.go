@@ -15,11 +15,29 @@ const (
 	ConfigParserDriverJson              = "flam.config.parsers.driver.json"
 	ConfigSourceCreatorGroup            = "flam.config.sources.creator"
 	ConfigSourceDriverEnv               = "flam.config.sources.driver.env"
+	ConfigSourceDriverFlags             = "flam.config.sources.driver.flags"
 	ConfigSourceDriverFile              = "flam.config.sources.driver.file"
 	ConfigSourceDriverObservableFile    = "flam.config.sources.driver.observable-file"
 	ConfigSourceDriverDir               = "flam.config.sources.driver.dir"
+	ConfigSourceDriverObservableDir     = "flam.config.sources.driver.observable-dir"
 	ConfigSourceDriverRest              = "flam.config.sources.driver.rest"
 	ConfigSourceDriverObservableRest    = "flam.config.sources.driver.observable-rest"
+	ConfigSourceDriverRedis             = "flam.config.sources.driver.redis"
+	ConfigSourceDriverDatabase          = "flam.config.sources.driver.database"
+	ConfigSourceRestModeTimestamp       = "timestamp"
+	ConfigSourceRestModeConditional     = "conditional"
+	ConfigSecretResolverGroup           = "flam.config.secrets.resolver"
+	ConfigSecretScheme                  = "secret://"
+	ConfigSecretDriverEnv               = "env"
+	ConfigSecretDriverFile              = "file"
+	ConfigEncryptionPrefix              = "enc:"
+	ConfigRedisKeyspaceChannel          = "__keyspace@%d__:%s"
+	ConfigDatabaseTypeString            = "string"
+	ConfigDatabaseTypeInt               = "int"
+	ConfigDatabaseTypeFloat             = "float"
+	ConfigDatabaseTypeBool              = "bool"
+	ConfigDatabaseTypeDuration          = "duration"
+	ConfigDatabaseTypeJson              = "json"
 	LogSerializerCreatorGroup           = "flam.log.serializers.creator"
 	LogSerializerDriverString           = "flam.log.serializers.driver.string"
 	LogSerializerDriverJson             = "flam.log.serializers.driver.json"
@@ -57,16 +75,57 @@ const (
 	ProcessGroup                        = "flam.process"
 	WatchdogLoggerCreatorGroup          = "flam.watchdog.loggers.creator"
 	WatchdogLoggerDriverDefault         = "flam.watchdog.loggers.driver.default"
+	HealthCheckerGroup                  = "flam.health.checkers"
+	HealthCheckerDatabase               = "database"
+	HealthCheckerRedis                  = "redis"
+	HealthCheckerKennel                 = "kennel"
+	AdminProcessId                      = "admin"
+	CommandGroup                        = "flam.commands"
+	CommandInput                        = "flam.commands.input"
+	CommandHelp                         = "help"
+	CommandMigratorList                 = "migrator:list"
+	CommandMigratorUp                   = "migrator:up"
+	CommandMigratorUpAll                = "migrator:up-all"
+	CommandMigratorDown                 = "migrator:down"
+	CommandMigratorDownAll              = "migrator:down-all"
+	CommandConfigSources                = "config:sources"
+	CommandConfigDump                   = "config:dump"
+	CommandConfigEncrypt                = "config:encrypt"
+	CommandKennelProcesses              = "kennel:processes"
+	ConfigRedactedValue                 = "********"
+	ConfigRedactMinValueLength          = 4
+
+	EventApplicationBooted        = "flam.events.application.booted"
+	EventApplicationRunning       = "flam.events.application.running"
+	EventApplicationClosing       = "flam.events.application.closing"
+	EventConfigSourceAdded        = "flam.events.config.sources.added"
+	EventConfigSourceRemoved      = "flam.events.config.sources.removed"
+	EventConfigSourceReloaded     = "flam.events.config.sources.reloaded"
+	EventFactoryResourceGenerated = "flam.events.factory.resources.generated"
+	EventFactoryResourceRemoved   = "flam.events.factory.resources.removed"
+	EventMigrationApplied         = "flam.events.migrations.applied"
+	EventMigrationReverted        = "flam.events.migrations.reverted"
+	EventProcessStarted           = "flam.events.processes.started"
+	EventProcessCrashed           = "flam.events.processes.crashed"
+	EventProcessStopped           = "flam.events.processes.stopped"
 
 	DefaultConfigSourceId            = "__app"
 	DefaultConfigBoot                = false
 	DefaultConfigObserverFrequency   = time.Minute
+	DefaultConfigWatcherEnabled      = false
+	DefaultConfigWatcherDebounce     = 100 * time.Millisecond
 	DefaultConfigFileParserId        = "yaml"
 	DefaultConfigFileDiskId          = "os"
 	DefaultConfigRestParserId        = "json"
 	DefaultConfigRestConfigPath      = "data.config"
 	DefaultConfigRestTimestampPath   = "data.timestamp"
+	DefaultConfigRestTimeout         = 30 * time.Second
+	DefaultConfigRestRetryAttempts   = 1
+	DefaultConfigRestRetryBackoff    = 100 * time.Millisecond
+	DefaultConfigDatabaseTable       = "__config"
 	DefaultConfigPriority            = 0
+	DefaultConfigEnvSeparator        = "__"
+	DefaultConfigSecretDiskId        = "os"
 	DefaultLogBoot                   = false
 	DefaultLogFlusherFrequency       = time.Minute
 	DefaultLogLevel                  = LogInfo
@@ -104,16 +163,38 @@ const (
 	DefaultWatchdogLoggerStartLevel  = LogInfo
 	DefaultWatchdogLoggerErrorLevel  = LogError
 	DefaultWatchdogLoggerDoneLevel   = LogInfo
+	DefaultShutdownTimeout           = 30 * time.Second
+	DefaultHealthTimeout             = 5 * time.Second
+	DefaultAdminBoot                 = false
+	DefaultAdminAddress              = "127.0.0.1:8090"
+	DefaultContainerVerify           = false
+	DefaultFactoryWarmup             = false
+	DefaultSubsystemDatabase         = true
+	DefaultSubsystemMigrator         = true
+	DefaultSubsystemRedis            = true
+	DefaultSubsystemCache            = true
+	DefaultSubsystemValidator        = true
+	DefaultSubsystemKennel           = true
 
 	PathDisks                            = "flam.disks"
 	PathConfigBoot                       = "flam.config.boot"
 	PathConfigObserverFrequency          = "flam.config.observer"
+	PathConfigWatcherEnabled             = "flam.config.watcher.enabled"
+	PathConfigWatcherDebounce            = "flam.config.watcher.debounce"
 	PathConfigDefaultFileParserId        = "flam.config.defaults.file.parser_id"
 	PathConfigDefaultFileDiskId          = "flam.config.defaults.file.disk_id"
 	PathConfigDefaultRestParserId        = "flam.config.defaults.rest.parser_id"
 	PathConfigDefaultRestConfigPath      = "flam.config.defaults.rest.config.path"
 	PathConfigDefaultRestTimestampPath   = "flam.config.defaults.rest.timestamp.path"
+	PathConfigDefaultRestTimeout         = "flam.config.defaults.rest.timeout"
+	PathConfigDefaultRestRetryAttempts   = "flam.config.defaults.rest.retry.attempts"
+	PathConfigDefaultRestRetryBackoff    = "flam.config.defaults.rest.retry.backoff"
+	PathConfigDefaultDatabaseTable       = "flam.config.defaults.database.table"
 	PathConfigDefaultPriority            = "flam.config.defaults.priority"
+	PathConfigDefaultEnvSeparator        = "flam.config.defaults.env.separator"
+	PathConfigSecretDiskId               = "flam.config.secrets.disk_id"
+	PathConfigEncryptionKeys             = "flam.config.encryption.keys"
+	PathConfigEncryptionPaths            = "flam.config.encryption.paths"
 	PathConfigParsers                    = "flam.config.parsers"
 	PathConfigSources                    = "flam.config.sources"
 	PathLogBoot                          = "flam.log.boot"
@@ -170,4 +251,18 @@ const (
 	PathWatchdogDefaultLoggerDoneLevel   = "flam.watchdog.defaults.log.done.level"
 	PathWatchdogLoggers                  = "flam.watchdog.loggers"
 	PathProcesses                        = "flam.processes"
+	PathShutdownTimeout                  = "flam.shutdown.timeout"
+	PathHealthTimeout                    = "flam.health.timeout"
+	PathHealthChecks                     = "flam.health.checks"
+	PathAdminBoot                        = "flam.admin.boot"
+	PathAdminAddress                     = "flam.admin.address"
+	PathConfigRedact                     = "flam.config.redact"
+	PathContainerVerify                  = "flam.container.verify"
+	PathFactoryWarmup                    = "flam.factory.warmup"
+	PathSubsystemDatabase                = "flam.subsystems.database"
+	PathSubsystemMigrator                = "flam.subsystems.migrator"
+	PathSubsystemRedis                   = "flam.subsystems.redis"
+	PathSubsystemCache                   = "flam.subsystems.cache"
+	PathSubsystemValidator               = "flam.subsystems.validator"
+	PathSubsystemKennel                  = "flam.subsystems.kennel"
 )
@@ -0,0 +1,9 @@
+package flam
+
+type ContainerEntry struct {
+	Provider    string
+	Constructor string
+	Inputs      []string
+	Outputs     []string
+	Groups      []string
+}
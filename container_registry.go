@@ -0,0 +1,110 @@
+package flam
+
+import (
+	"reflect"
+	"regexp"
+	"slices"
+	"sync"
+
+	"go.uber.org/dig"
+)
+
+var containerGroupPattern = regexp.MustCompile(`group = "([^"]*)"`)
+
+type containerRegistryEntry struct {
+	entry       ContainerEntry
+	constructor any
+}
+
+type containerRegistry struct {
+	mu       sync.Mutex
+	provider string
+	entries  []containerRegistryEntry
+}
+
+func newContainerRegistry() *containerRegistry {
+	return &containerRegistry{}
+}
+
+func (registry *containerRegistry) use(
+	provider string,
+) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	registry.provider = provider
+}
+
+func (registry *containerRegistry) record(
+	constructor any,
+	info dig.ProvideInfo,
+) {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	entry := ContainerEntry{
+		Provider:    registry.provider,
+		Constructor: callbackName(constructor)}
+
+	for _, input := range info.Inputs {
+		entry.Inputs = append(entry.Inputs, input.String())
+	}
+
+	for _, output := range info.Outputs {
+		entry.Outputs = append(entry.Outputs, output.String())
+		for _, match := range containerGroupPattern.FindAllStringSubmatch(output.String(), -1) {
+			if !slices.Contains(entry.Groups, match[1]) {
+				entry.Groups = append(entry.Groups, match[1])
+			}
+		}
+	}
+
+	registry.entries = append(registry.entries, containerRegistryEntry{
+		entry:       entry,
+		constructor: constructor})
+}
+
+func (registry *containerRegistry) list(
+	providers ...string,
+) []ContainerEntry {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+
+	var entries []ContainerEntry
+	for _, reg := range registry.entries {
+		if len(providers) == 0 || slices.Contains(providers, reg.entry.Provider) {
+			entries = append(entries, reg.entry)
+		}
+	}
+
+	return entries
+}
+
+func (registry *containerRegistry) verify(
+	container *dig.Container,
+) error {
+	registry.mu.Lock()
+	entries := slices.Clone(registry.entries)
+	registry.mu.Unlock()
+
+	errs := NewMultiError(ErrContainerVerificationFailed)
+	for _, reg := range entries {
+		constructorType := reflect.TypeOf(reg.constructor)
+		if constructorType.Kind() != reflect.Func || constructorType.NumIn() == 0 {
+			continue
+		}
+
+		var inputs []reflect.Type
+		for i := range constructorType.NumIn() {
+			inputs = append(inputs, constructorType.In(i))
+		}
+
+		resolver := reflect.MakeFunc(
+			reflect.FuncOf(inputs, nil, constructorType.IsVariadic()),
+			func([]reflect.Value) []reflect.Value { return nil })
+
+		errs.Add(reg.entry.Provider+": "+reg.entry.Constructor, container.Invoke(resolver.Interface()))
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -23,6 +23,7 @@ type databaseConfigFactoryArgs struct {
 
 	Creators      []DatabaseConfigCreator `group:"flam.database.configs.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newDatabaseConfigFactory(
@@ -37,5 +38,6 @@ func newDatabaseConfigFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("DatabaseConfig"),
-		PathDatabaseConfigs)
+		PathDatabaseConfigs,
+		args.PubSub)
 }
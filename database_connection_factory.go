@@ -17,6 +17,7 @@ type DatabaseConnectionFactory interface {
 func newDatabaseConnectionFactory(
 	connectionCreator *databaseConnectionCreator,
 	factoryConfig FactoryConfig,
+	pubSub PubSub[string, string],
 ) (DatabaseConnectionFactory, error) {
 	creators := []FactoryResourceCreator[DatabaseConnection]{connectionCreator}
 
@@ -24,5 +25,6 @@ func newDatabaseConnectionFactory(
 		creators,
 		factoryConfig,
 		nil,
-		PathDatabaseConnections)
+		PathDatabaseConnections,
+		pubSub)
 }
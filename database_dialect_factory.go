@@ -23,6 +23,7 @@ type databaseDialectFactoryArgs struct {
 
 	Creators      []DatabaseDialectCreator `group:"flam.database.dialects.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newDatabaseDialectFactory(
@@ -37,5 +38,6 @@ func newDatabaseDialectFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("DatabaseDialect"),
-		PathDatabaseDialects)
+		PathDatabaseDialects,
+		args.PubSub)
 }
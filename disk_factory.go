@@ -23,6 +23,7 @@ type diskFactoryArgs struct {
 
 	Creators      []DiskCreator `group:"flam.disks.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newDiskFactory(
@@ -37,5 +38,6 @@ func newDiskFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("Disk"),
-		PathDisks)
+		PathDisks,
+		args.PubSub)
 }
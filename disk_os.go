@@ -23,3 +23,10 @@ func (osDiskCreator) Create(
 ) (Disk, error) {
 	return afero.NewOsFs(), nil
 }
+
+func isOsDisk(
+	disk Disk,
+) bool {
+	_, ok := disk.(*afero.OsFs)
+	return ok
+}
@@ -3,6 +3,8 @@ package flam
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 )
 
 var (
@@ -16,11 +18,20 @@ var (
 	ErrDuplicateSubscription             = errors.New("duplicate subscription")
 	ErrInvalidSubscriptionChannelPattern = errors.New("invalid subscription channel pattern")
 	ErrPublishFailed                     = errors.New("publish failed")
+	ErrExecutionFailed                   = errors.New("execution failed")
+	ErrCloseFailed                       = errors.New("close failed")
+	ErrServeFailed                       = errors.New("serve failed")
 	ErrDuplicateProvider                 = errors.New("duplicate provider")
+	ErrUnknownProviderDependency         = errors.New("unknown provider dependency")
+	ErrCyclicProviderDependency          = errors.New("cyclic provider dependency")
+	ErrProviderCloseTimeout              = errors.New("provider close timeout")
+	ErrInvalidApplicationState           = errors.New("invalid application state")
 	ErrRestConfigSourceConfigNotFound    = errors.New("config rest source config source data not found")
 	ErrInvalidRestConfigSourceConfig     = errors.New("invalid config rest source config source data")
 	ErrRestConfigSourceTimestampNotFound = errors.New("config rest source config source timestamp not found")
 	ErrInvalidRestConfigSourceTimestamp  = errors.New("invalid config rest source config source timestamp")
+	ErrRestConfigSourceStatus            = errors.New("unexpected config rest source response status")
+	ErrInvalidDatabaseConfigSourceValue  = errors.New("invalid config database source value")
 	ErrDuplicateConfigObserver           = errors.New("duplicate config observer")
 	ErrUnknownDatabaseLogType            = errors.New("unknown database log type")
 	ErrUnknownDatabaseLogLevel           = errors.New("unknown database log level")
@@ -29,6 +40,20 @@ var (
 	ErrProcessNotFound                   = errors.New("watchdog process not found")
 	ErrProcessIsRunning                  = errors.New("watchdog process is currently running")
 	ErrProcessRunningError               = errors.New("watchdog process running error")
+	ErrProcessNotRunning                 = errors.New("watchdog process is not running")
+	ErrHealthCheckTimeout                = errors.New("health check timeout")
+	ErrRegistrationFailed                = errors.New("registration failed")
+	ErrContainerVerificationFailed       = errors.New("container verification failed")
+	ErrInvalidConfig                     = errors.New("invalid config")
+	ErrDuplicateCommand                  = errors.New("duplicate command")
+	ErrUnknownCommand                    = errors.New("unknown command")
+	ErrInvalidCommandArguments           = errors.New("invalid command arguments")
+	ErrWarmupFailed                      = errors.New("factory warmup failed")
+	ErrConfigInterpolationCycle          = errors.New("config interpolation cycle")
+	ErrUnresolvedConfigInterpolation     = errors.New("unresolved config interpolation reference")
+	ErrSecretNotFound                    = errors.New("secret not found")
+	ErrInvalidConfigEncryptionKey        = errors.New("invalid config encryption key")
+	ErrInvalidEncryptedConfigValue       = errors.New("invalid encrypted config value")
 )
 
 func newErrNilReference(
@@ -55,14 +80,45 @@ func newErrInvalidResourceConfig(
 	field string,
 	config Bag,
 ) error {
-	return NewErrorFrom(ErrInvalidResourceConfig, fmt.Sprintf("%s[%s] => %v", resource, field, config))
+	return NewErrorFrom(ErrInvalidResourceConfig, fmt.Sprintf("%s[%s] => %v", resource, field, redactBag(config, "", configRedactDefaultKeys, nil, nil)))
 }
 
 func newErrUnacceptedResourceConfig(
 	resource string,
 	config Bag,
 ) error {
-	return NewErrorFrom(ErrUnacceptedResourceConfig, fmt.Sprintf("%s => %v", resource, config))
+	return NewErrorFrom(ErrUnacceptedResourceConfig, fmt.Sprintf("%s => %v", resource, redactBag(config, "", configRedactDefaultKeys, nil, nil)))
+}
+
+func newErrConfigInterpolationCycle(
+	paths []string,
+) error {
+	return NewErrorFrom(ErrConfigInterpolationCycle, strings.Join(paths, " -> "))
+}
+
+func newErrUnresolvedConfigInterpolation(
+	reference string,
+) error {
+	return NewErrorFrom(ErrUnresolvedConfigInterpolation, reference)
+}
+
+func newErrSecretNotFound(
+	driver string,
+	reference string,
+) error {
+	return NewErrorFrom(ErrSecretNotFound, fmt.Sprintf("%s/%s", driver, reference))
+}
+
+func newErrInvalidConfigEncryptionKey(
+	keyId string,
+) error {
+	return NewErrorFrom(ErrInvalidConfigEncryptionKey, keyId)
+}
+
+func newErrInvalidEncryptedConfigValue(
+	msg string,
+) error {
+	return NewErrorFrom(ErrInvalidEncryptedConfigValue, msg)
 }
 
 func newErrDuplicateResource(
@@ -91,17 +147,30 @@ func newErrInvalidSubscriptionChannelPattern(
 	return NewErrorFrom(ErrInvalidSubscriptionChannelPattern, channel)
 }
 
-func newErrPublishFailed(
-	errs []error,
+func newErrDuplicateProvider(
+	id string,
 ) error {
-	joinedErr := errors.Join(errs...)
-	return NewErrorFrom(joinedErr, fmt.Sprintf("%d handler(s) failed", len(errs)))
+	return NewErrorFrom(ErrDuplicateProvider, id)
 }
 
-func newErrDuplicateProvider(
+func newErrUnknownProviderDependency(
 	id string,
+	dependency string,
 ) error {
-	return NewErrorFrom(ErrDuplicateProvider, id)
+	return NewErrorFrom(ErrUnknownProviderDependency, fmt.Sprintf("%s => %s", id, dependency))
+}
+
+func newErrCyclicProviderDependency(
+	path []string,
+) error {
+	return NewErrorFrom(ErrCyclicProviderDependency, strings.Join(path, " => "))
+}
+
+func newErrInvalidApplicationState(
+	state ApplicationState,
+	action string,
+) error {
+	return NewErrorFrom(ErrInvalidApplicationState, fmt.Sprintf("%s => %s", state, action))
 }
 
 func newErrRestConfigSourceConfigNotFound(
@@ -125,6 +194,22 @@ func newErrRestConfigSourceTimestampNotFound(
 	return NewErrorFrom(ErrRestConfigSourceTimestampNotFound, fmt.Sprintf("%s => %v", path, config))
 }
 
+func newErrRestConfigSourceStatus(
+	uri string,
+	status int,
+	body string,
+) error {
+	return NewErrorFrom(ErrRestConfigSourceStatus, fmt.Sprintf("%s => %d %s: %s", uri, status, http.StatusText(status), body))
+}
+
+func newErrInvalidDatabaseConfigSourceValue(
+	path string,
+	kind string,
+	value string,
+) error {
+	return NewErrorFrom(ErrInvalidDatabaseConfigSourceValue, fmt.Sprintf("%s => %s(%s)", path, kind, value))
+}
+
 func newErrInvalidRestConfigSourceTimestamp(
 	path string,
 	value any,
@@ -174,3 +259,50 @@ func newErrProcessRunningError(
 ) error {
 	return NewErrorFrom(ErrProcessRunningError, fmt.Sprintf("%v", result))
 }
+
+func newErrHealthCheckTimeout(
+	id string,
+) error {
+	return NewErrorFrom(ErrHealthCheckTimeout, id)
+}
+
+func newErrHealthCheckFailed(
+	resource string,
+	id string,
+	e error,
+) error {
+	return NewErrorFrom(e, fmt.Sprintf("%s[%s]", resource, id))
+}
+
+func newErrDuplicateCommand(
+	name string,
+) error {
+	return NewErrorFrom(ErrDuplicateCommand, name)
+}
+
+func newErrUnknownCommand(
+	name string,
+) error {
+	return NewErrorFrom(ErrUnknownCommand, name)
+}
+
+func newErrInvalidCommandArguments(
+	usage string,
+	args []string,
+) error {
+	return NewErrorFrom(ErrInvalidCommandArguments, fmt.Sprintf("%s => %v", usage, args))
+}
+
+func newErrInvalidConfig(
+	violations []ConfigViolation,
+) error {
+	return &ConfigValidationError{
+		Violations: violations}
+}
+
+func newErrRegistrationFailed(
+	constructor string,
+	e error,
+) error {
+	return NewErrorFrom(ErrRegistrationFailed, fmt.Sprintf("%s => %v", constructor, e))
+}
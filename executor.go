@@ -46,15 +46,10 @@ func (executor *executor) Run(
 		return newErrNilReference("container")
 	}
 
-	var errors []error
+	errs := NewMultiError(ErrExecutionFailed)
 	for _, entry := range executor.entries {
-		if e := container.Invoke(entry.callback); e != nil {
-			errors = append(errors, e)
-		}
-	}
-	if len(errors) > 0 {
-		return newErrPublishFailed(errors)
+		errs.Add(callbackName(entry.callback), container.Invoke(entry.callback))
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
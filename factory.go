@@ -49,16 +49,19 @@ type factory[R FactoryResource] struct {
 	factoryConfig          FactoryConfig
 	factoryConfigValidator FactoryConfigValidator
 	factoryConfigPath      string
+	pubSub                 PubSub[string, string]
 	entries                map[string]R
 }
 
 var _ Factory[string] = (*factory[string])(nil)
+var _ factoryWarmer = (*factory[string])(nil)
 
 func NewFactory[R FactoryResource](
 	creators []FactoryResourceCreator[R],
 	factoryConfig FactoryConfig,
 	factoryConfigValidator FactoryConfigValidator,
 	factoryConfigPath string,
+	pubSub ...PubSub[string, string],
 ) (Factory[R], error) {
 	if factoryConfig == nil {
 		return nil, newErrNilReference("config")
@@ -70,6 +73,7 @@ func NewFactory[R FactoryResource](
 		factoryConfig:          factoryConfig,
 		factoryConfigValidator: factoryConfigValidator,
 		factoryConfigPath:      factoryConfigPath,
+		pubSub:                 append(pubSub, nil)[0],
 		entries:                map[string]R{}}, nil
 }
 
@@ -160,6 +164,22 @@ func (factory *factory[R]) Store(
 
 func (factory *factory[R]) Generate(
 	id string,
+) (R, error) {
+	entry, e := factory.generate(id)
+	if e != nil {
+		if redactor, ok := factory.factoryConfig.(factoryErrorRedactor); ok {
+			e = redactor.redact(e)
+		}
+		return entry, e
+	}
+
+	publish(factory.pubSub, EventFactoryResourceGenerated, reflect.TypeFor[R]().Name(), id)
+
+	return entry, nil
+}
+
+func (factory *factory[R]) generate(
+	id string,
 ) (R, error) {
 	factory.locker.Lock()
 	defer factory.locker.Unlock()
@@ -218,51 +238,88 @@ func (factory *factory[R]) GenerateAll() error {
 	return nil
 }
 
+func (factory *factory[R]) Warmup(
+	all bool,
+) error {
+	factoryConfig := factory.factoryConfig.Get(factory.factoryConfigPath)
+	ids := factoryConfig.Entries()
+	slices.SortFunc(ids, strings.Compare)
+
+	errs := NewMultiError(ErrWarmupFailed)
+	for _, id := range ids {
+		if !all && !factoryConfig.Bool(id+".boot") {
+			continue
+		}
+
+		factory.locker.Lock()
+		_, ok := factory.entries[id]
+		factory.locker.Unlock()
+		if ok {
+			continue
+		}
+
+		_, e := factory.Generate(id)
+		errs.Add(factory.factoryConfigPath+"."+id, e)
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func (factory *factory[R]) Remove(
 	id string,
 ) error {
 	factory.locker.Lock()
-	defer factory.locker.Unlock()
 
 	entry, ok := factory.entries[id]
 	if !ok {
+		factory.locker.Unlock()
 		return newErrUnknownResource(reflect.TypeFor[R]().Name(), id)
 	}
 
 	singleEntry := map[string]R{id: entry}
 	if e := factory.closeEntries(singleEntry); e != nil {
+		factory.locker.Unlock()
 		return e
 	}
 
 	delete(factory.entries, id)
+	factory.locker.Unlock()
+
+	publish(factory.pubSub, EventFactoryResourceRemoved, reflect.TypeFor[R]().Name(), id)
 
 	return nil
 }
 
 func (factory *factory[R]) RemoveAll() error {
 	factory.locker.Lock()
-	defer factory.locker.Unlock()
 
 	if e := factory.closeEntries(factory.entries); e != nil {
+		factory.locker.Unlock()
 		return e
 	}
 
+	var ids []string
+	for id := range factory.entries {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, strings.Compare)
+
 	factory.entries = map[string]R{}
+	factory.locker.Unlock()
+
+	for _, id := range ids {
+		publish(factory.pubSub, EventFactoryResourceRemoved, reflect.TypeFor[R]().Name(), id)
+	}
 
 	return nil
 }
 
 func (factory *factory[R]) closeEntries(entries map[string]R) error {
-	var errors []error
-	for _, entry := range entries {
+	errs := NewMultiError(ErrCloseFailed)
+	for id, entry := range entries {
 		if closer, ok := any(entry).(io.Closer); ok {
-			if e := closer.Close(); e != nil {
-				errors = append(errors, e)
-			}
+			errs.Add(id, closer.Close())
 		}
 	}
-	if len(errors) > 0 {
-		return newErrPublishFailed(errors)
-	}
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -4,17 +4,25 @@ type FactoryConfig interface {
 	Get(path string, def ...any) Bag
 }
 
+type factoryErrorRedactor interface {
+	redact(e error) error
+}
+
 type factoryConfig struct {
-	config *config
+	config   *config
+	redactor *configRedactor
 }
 
 var _ FactoryConfig = (*factoryConfig)(nil)
+var _ factoryErrorRedactor = (*factoryConfig)(nil)
 
 func newFactoryConfig(
 	config *config,
+	redactor *configRedactor,
 ) FactoryConfig {
 	return &factoryConfig{
-		config: config}
+		config:   config,
+		redactor: redactor}
 }
 
 func (config *factoryConfig) Get(
@@ -44,3 +52,13 @@ func (config *factoryConfig) Get(
 
 	return Bag{}
 }
+
+func (config *factoryConfig) redact(
+	e error,
+) error {
+	if config.redactor == nil {
+		return e
+	}
+
+	return config.redactor.error(e)
+}
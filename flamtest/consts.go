@@ -0,0 +1,12 @@
+package flamtest
+
+const (
+	DiskId               = "memory"
+	DatabaseDialectId    = "sqlite"
+	DatabaseConfigId     = "memory"
+	DatabaseConnectionId = "memory"
+	RedisConnectionId    = "mini"
+	LogStreamId          = "memory"
+	MigratorId           = "memory"
+	MigrationGroup       = "flamtest"
+)
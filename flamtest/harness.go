@@ -0,0 +1,209 @@
+package flamtest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+var databaseCounter atomic.Int64
+
+var subsystems = []string{
+	flam.PathSubsystemDatabase,
+	flam.PathSubsystemMigrator,
+	flam.PathSubsystemRedis,
+	flam.PathSubsystemCache,
+	flam.PathSubsystemValidator,
+	flam.PathSubsystemKennel}
+
+type Harness struct {
+	flam.Application
+
+	t      testing.TB
+	config flam.Bag
+	logs   *LogStream
+}
+
+var _ flam.Application = (*Harness)(nil)
+
+func New(
+	t testing.TB,
+	config ...flam.Bag,
+) *Harness {
+	t.Helper()
+
+	harness := &Harness{
+		t:      t,
+		config: defaultConfig(),
+		logs:   NewLogStream()}
+
+	for _, override := range config {
+		harness.config.Merge(override)
+	}
+
+	harness.Application = flam.NewApplication(harness.config)
+	t.Cleanup(func() {
+		// Error ignored - the application closing is best effort on test cleanup
+		_ = harness.Application.Close()
+	})
+
+	harness.must(harness.storeLogs())
+
+	return harness
+}
+
+func (harness *Harness) Restart() error {
+	return harness.RestartContext(context.Background())
+}
+
+func (harness *Harness) RestartContext(
+	ctx context.Context,
+) error {
+	if harness.State() != flam.ApplicationStateRegistered {
+		if e := harness.Application.CloseContext(ctx); e != nil {
+			return e
+		}
+
+		if e := harness.storeLogs(); e != nil {
+			return e
+		}
+	}
+
+	return harness.Application.RestartContext(ctx)
+}
+
+func (harness *Harness) Logs() *LogStream {
+	harness.t.Helper()
+
+	harness.Invoke(func(logger flam.Logger) error {
+		return logger.Flush()
+	})
+
+	return harness.logs
+}
+
+func (harness *Harness) Set(
+	path string,
+	value any,
+) *Harness {
+	harness.t.Helper()
+
+	if slices.ContainsFunc(subsystems, func(subsystem string) bool {
+		return path == subsystem || strings.HasPrefix(subsystem, path+".")
+	}) {
+		harness.t.Fatalf("flamtest: the %s subsystem flag must be given to New", path)
+		return harness
+	}
+
+	if harness.State() == flam.ApplicationStateRegistered {
+		harness.must(harness.config.Set(path, value))
+		return harness
+	}
+
+	harness.must(harness.Container().Invoke(func(config flam.Config) error {
+		return config.Set(path, value)
+	}))
+
+	return harness
+}
+
+func (harness *Harness) AddProcess(
+	process flam.Process,
+	active ...bool,
+) *Harness {
+	harness.t.Helper()
+
+	harness.Set(flam.PathProcesses+"."+process.Id()+".active", append(active, true)[0])
+	harness.must(harness.Container().Provide(func() flam.Process {
+		return process
+	}, dig.Group(flam.ProcessGroup)))
+
+	return harness
+}
+
+func (harness *Harness) AddMigration(
+	migration flam.Migration,
+) *Harness {
+	harness.t.Helper()
+
+	harness.must(harness.Container().Provide(func() flam.Migration {
+		return migration
+	}, dig.Group(flam.MigrationGroup)))
+
+	return harness
+}
+
+func (harness *Harness) MustBoot() *Harness {
+	harness.t.Helper()
+
+	harness.must(harness.Application.Boot())
+
+	return harness
+}
+
+func (harness *Harness) Invoke(
+	function any,
+) {
+	harness.t.Helper()
+
+	harness.must(harness.Container().Invoke(function))
+}
+
+func (harness *Harness) storeLogs() error {
+	return harness.Container().Invoke(func(factory flam.LogStreamFactory) error {
+		return factory.Store(LogStreamId, harness.logs)
+	})
+}
+
+func (harness *Harness) must(
+	e error,
+) {
+	harness.t.Helper()
+
+	if e != nil {
+		harness.t.Fatalf("flamtest: %v", e)
+	}
+}
+
+func defaultConfig() flam.Bag {
+	database := fmt.Sprintf("file:flamtest_%d?mode=memory&cache=shared", databaseCounter.Add(1))
+
+	config := flam.Bag{}
+	// Errors ignored - setting default values on a fresh bag, shouldn't fail
+	_ = config.Set(flam.PathDisks, flam.Bag{
+		DiskId: flam.Bag{
+			"driver": flam.DiskDriverMemory}})
+	_ = config.Set(flam.PathConfigDefaultFileDiskId, DiskId)
+	_ = config.Set(flam.PathLogDefaultDiskId, DiskId)
+	_ = config.Set(flam.PathDatabaseDialects, flam.Bag{
+		DatabaseDialectId: flam.Bag{
+			"driver": flam.DatabaseDialectDriverSqlite,
+			"host":   database}})
+	_ = config.Set(flam.PathDatabaseConfigs, flam.Bag{
+		DatabaseConfigId: flam.Bag{
+			"driver": flam.DatabaseConfigDriverDefault,
+			"logger": flam.Bag{
+				"type": flam.DatabaseConfigLoggerDiscard}}})
+	_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+		DatabaseConnectionId: flam.Bag{
+			"dialect_id": DatabaseDialectId,
+			"config_id":  DatabaseConfigId}})
+	_ = config.Set(flam.PathRedisMiniBoot, true)
+	_ = config.Set(flam.PathRedisConnections, flam.Bag{
+		RedisConnectionId: flam.Bag{
+			"driver": flam.RedisConnectionDriverMini}})
+	_ = config.Set(flam.PathMigrators, flam.Bag{
+		MigratorId: flam.Bag{
+			"driver":        flam.MigratorDriverDefault,
+			"connection_id": DatabaseConnectionId,
+			"group":         MigrationGroup}})
+
+	return config
+}
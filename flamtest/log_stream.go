@@ -0,0 +1,203 @@
+package flamtest
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+type LogEntry struct {
+	Timestamp time.Time
+	Level     flam.LogLevel
+	Channel   string
+	Message   string
+	Context   flam.Bag
+}
+
+type LogStream struct {
+	mu       sync.Mutex
+	level    flam.LogLevel
+	channels []string
+	entries  []LogEntry
+}
+
+var _ flam.LogStream = (*LogStream)(nil)
+
+func NewLogStream() *LogStream {
+	return &LogStream{
+		level:    flam.LogDebug,
+		channels: []string{"*"}}
+}
+
+func (stream *LogStream) Close() error {
+	return nil
+}
+
+func (stream *LogStream) GetLevel() flam.LogLevel {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return stream.level
+}
+
+func (stream *LogStream) SetLevel(
+	level flam.LogLevel,
+) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.level = level
+
+	return nil
+}
+
+func (stream *LogStream) HasChannel(
+	channel string,
+) bool {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return slices.Contains(stream.channels, channel)
+}
+
+func (stream *LogStream) ListChannels() []string {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return slices.Clone(stream.channels)
+}
+
+func (stream *LogStream) AddChannel(
+	channel string,
+) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if !slices.Contains(stream.channels, channel) {
+		stream.channels = append(stream.channels, channel)
+		sort.Strings(stream.channels)
+	}
+
+	return nil
+}
+
+func (stream *LogStream) RemoveChannel(
+	channel string,
+) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.channels = slices.DeleteFunc(stream.channels, func(c string) bool {
+		return c == channel
+	})
+
+	return nil
+}
+
+func (stream *LogStream) RemoveAllChannels() error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.channels = []string{}
+
+	return nil
+}
+
+func (stream *LogStream) Signal(
+	timestamp time.Time,
+	level flam.LogLevel,
+	channel string,
+	message string,
+	ctx flam.Bag,
+) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	if !slices.Contains(stream.channels, "*") && !slices.Contains(stream.channels, channel) {
+		return nil
+	}
+
+	stream.record(timestamp, level, channel, message, ctx)
+
+	return nil
+}
+
+func (stream *LogStream) Broadcast(
+	timestamp time.Time,
+	level flam.LogLevel,
+	message string,
+	ctx flam.Bag,
+) error {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.record(timestamp, level, "", message, ctx)
+
+	return nil
+}
+
+func (stream *LogStream) Entries() []LogEntry {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return slices.Clone(stream.entries)
+}
+
+func (stream *LogStream) Filter(
+	level flam.LogLevel,
+	channel string,
+) []LogEntry {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	var entries []LogEntry
+	for _, entry := range stream.entries {
+		if entry.Level == level && (channel == "" || entry.Channel == channel) {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func (stream *LogStream) Contains(
+	level flam.LogLevel,
+	message string,
+) bool {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	return slices.ContainsFunc(stream.entries, func(entry LogEntry) bool {
+		return entry.Level == level && strings.Contains(entry.Message, message)
+	})
+}
+
+func (stream *LogStream) Reset() {
+	stream.mu.Lock()
+	defer stream.mu.Unlock()
+
+	stream.entries = nil
+}
+
+func (stream *LogStream) record(
+	timestamp time.Time,
+	level flam.LogLevel,
+	channel string,
+	message string,
+	ctx flam.Bag,
+) {
+	if stream.level < level || stream.level == flam.LogNone {
+		return
+	}
+
+	stream.entries = append(stream.entries, LogEntry{
+		Timestamp: timestamp,
+		Level:     level,
+		Channel:   channel,
+		Message:   message,
+		Context:   ctx.Clone()})
+}
@@ -0,0 +1,98 @@
+package flamtest
+
+import (
+	"sync"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+type Migration struct {
+	mu          sync.Mutex
+	version     string
+	description string
+	up          func(connection flam.DatabaseConnection) error
+	down        func(connection flam.DatabaseConnection) error
+	ups         int
+	downs       int
+}
+
+var _ flam.Migration = (*Migration)(nil)
+
+func NewMigration(
+	version string,
+	description string,
+) *Migration {
+	return &Migration{
+		version:     version,
+		description: description}
+}
+
+func (migration *Migration) OnUp(
+	up func(connection flam.DatabaseConnection) error,
+) *Migration {
+	migration.up = up
+
+	return migration
+}
+
+func (migration *Migration) OnDown(
+	down func(connection flam.DatabaseConnection) error,
+) *Migration {
+	migration.down = down
+
+	return migration
+}
+
+func (migration *Migration) Group() string {
+	return MigrationGroup
+}
+
+func (migration *Migration) Version() string {
+	return migration.version
+}
+
+func (migration *Migration) Description() string {
+	return migration.description
+}
+
+func (migration *Migration) Ups() int {
+	migration.mu.Lock()
+	defer migration.mu.Unlock()
+
+	return migration.ups
+}
+
+func (migration *Migration) Downs() int {
+	migration.mu.Lock()
+	defer migration.mu.Unlock()
+
+	return migration.downs
+}
+
+func (migration *Migration) Up(
+	connection flam.DatabaseConnection,
+) error {
+	migration.mu.Lock()
+	migration.ups++
+	migration.mu.Unlock()
+
+	if migration.up == nil {
+		return nil
+	}
+
+	return migration.up(connection)
+}
+
+func (migration *Migration) Down(
+	connection flam.DatabaseConnection,
+) error {
+	migration.mu.Lock()
+	migration.downs++
+	migration.mu.Unlock()
+
+	if migration.down == nil {
+		return nil
+	}
+
+	return migration.down(connection)
+}
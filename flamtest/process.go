@@ -0,0 +1,86 @@
+package flamtest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+type Process struct {
+	mu      sync.Mutex
+	id      string
+	run     func(ctx context.Context) error
+	cancel  context.CancelFunc
+	running bool
+	runs    int
+}
+
+var _ flam.Process = (*Process)(nil)
+
+func NewProcess(
+	id string,
+	run ...func(ctx context.Context) error,
+) *Process {
+	return &Process{
+		id:  id,
+		run: append(run, nil)[0]}
+}
+
+func (process *Process) Id() string {
+	return process.id
+}
+
+func (process *Process) IsRunning() bool {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+
+	return process.running
+}
+
+func (process *Process) Runs() int {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+
+	return process.runs
+}
+
+func (process *Process) Run() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	process.mu.Lock()
+	process.cancel = cancel
+	process.running = true
+	process.runs++
+	process.mu.Unlock()
+
+	defer func() {
+		process.mu.Lock()
+		process.cancel = nil
+		process.running = false
+		process.mu.Unlock()
+	}()
+
+	if process.run == nil {
+		<-ctx.Done()
+		return nil
+	}
+
+	return process.run(ctx)
+}
+
+func (process *Process) Terminate() {
+	process.mu.Lock()
+	defer process.mu.Unlock()
+
+	if process.cancel != nil {
+		process.cancel()
+	}
+}
+
+func (process *Process) Close() error {
+	process.Terminate()
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package flam
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/dig"
+)
+
+type Health interface {
+	Checkers() []string
+	Check(ctx context.Context) HealthReport
+}
+
+type health struct {
+	config   Config
+	checkers []HealthChecker
+}
+
+var _ Health = (*health)(nil)
+
+func newHealth(args struct {
+	dig.In
+
+	Config   Config
+	Checkers []HealthChecker `group:"flam.health.checkers"`
+}) *health {
+	checkers := slices.Clone(args.Checkers)
+	slices.SortFunc(checkers, func(a, b HealthChecker) int {
+		return strings.Compare(a.Id(), b.Id())
+	})
+
+	return &health{
+		config:   args.Config,
+		checkers: checkers}
+}
+
+func (health *health) Checkers() []string {
+	var ids []string
+	for _, checker := range health.checkers {
+		ids = append(ids, checker.Id())
+	}
+
+	return ids
+}
+
+func (health *health) Check(
+	ctx context.Context,
+) HealthReport {
+	report := HealthReport{
+		Status: HealthStatusUp,
+		Checks: make([]HealthCheckReport, len(health.checkers))}
+
+	var wg sync.WaitGroup
+	for i, checker := range health.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = health.check(ctx, checker)
+		}()
+	}
+	wg.Wait()
+
+	for _, check := range report.Checks {
+		if check.Status != HealthStatusUp {
+			report.Status = HealthStatusDown
+		}
+	}
+
+	return report
+}
+
+func (health *health) check(
+	ctx context.Context,
+	checker HealthChecker,
+) HealthCheckReport {
+	id := checker.Id()
+	timeout := health.config.Duration(
+		PathHealthChecks+"."+id+".timeout",
+		health.config.Duration(PathHealthTimeout, DefaultHealthTimeout))
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	result := make(chan error, 1)
+	go func() {
+		result <- checker.Check(ctx)
+	}()
+
+	var e error
+	select {
+	case e = <-result:
+	case <-ctx.Done():
+		e = newErrHealthCheckTimeout(id)
+	}
+
+	report := HealthCheckReport{
+		Id:       id,
+		Status:   HealthStatusUp,
+		Duration: time.Since(start),
+		Error:    e}
+	if e != nil {
+		report.Status = HealthStatusDown
+	}
+
+	return report
+}
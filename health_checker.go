@@ -0,0 +1,8 @@
+package flam
+
+import "context"
+
+type HealthChecker interface {
+	Id() string
+	Check(ctx context.Context) error
+}
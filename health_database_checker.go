@@ -0,0 +1,53 @@
+package flam
+
+import (
+	"context"
+	"errors"
+)
+
+type databaseHealthChecker struct {
+	databaseConnectionFactory DatabaseConnectionFactory
+}
+
+var _ HealthChecker = (*databaseHealthChecker)(nil)
+
+func newDatabaseHealthChecker(
+	databaseConnectionFactory DatabaseConnectionFactory,
+) HealthChecker {
+	return &databaseHealthChecker{
+		databaseConnectionFactory: databaseConnectionFactory}
+}
+
+func (checker databaseHealthChecker) Id() string {
+	return HealthCheckerDatabase
+}
+
+func (checker databaseHealthChecker) Check(
+	ctx context.Context,
+) error {
+	var errs []error
+	for _, id := range checker.databaseConnectionFactory.Stored() {
+		if e := checker.ping(ctx, id); e != nil {
+			errs = append(errs, newErrHealthCheckFailed("DatabaseConnection", id, e))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (checker databaseHealthChecker) ping(
+	ctx context.Context,
+	id string,
+) error {
+	connection, e := checker.databaseConnectionFactory.Get(id)
+	if e != nil {
+		return e
+	}
+
+	db, e := connection.DB()
+	if e != nil {
+		return e
+	}
+
+	return db.PingContext(ctx)
+}
@@ -0,0 +1,47 @@
+package flam
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+type kennelHealthChecker struct {
+	config Config
+	kennel Kennel
+}
+
+var _ HealthChecker = (*kennelHealthChecker)(nil)
+
+func newKennelHealthChecker(
+	config Config,
+	kennel Kennel,
+) HealthChecker {
+	return &kennelHealthChecker{
+		config: config,
+		kennel: kennel}
+}
+
+func (checker kennelHealthChecker) Id() string {
+	return HealthCheckerKennel
+}
+
+func (checker kennelHealthChecker) Check(
+	_ context.Context,
+) error {
+	if !checker.config.Bool(PathKennelRun, false) {
+		return nil
+	}
+
+	ids := checker.kennel.Available()
+	slices.Sort(ids)
+
+	var errs []error
+	for _, id := range ids {
+		if checker.kennel.IsActive(id) && !checker.kennel.IsRunning(id) {
+			errs = append(errs, newErrHealthCheckFailed("Process", id, ErrProcessNotRunning))
+		}
+	}
+
+	return errors.Join(errs...)
+}
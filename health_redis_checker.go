@@ -0,0 +1,48 @@
+package flam
+
+import (
+	"context"
+	"errors"
+)
+
+type redisHealthChecker struct {
+	redisConnectionFactory RedisConnectionFactory
+}
+
+var _ HealthChecker = (*redisHealthChecker)(nil)
+
+func newRedisHealthChecker(
+	redisConnectionFactory RedisConnectionFactory,
+) HealthChecker {
+	return &redisHealthChecker{
+		redisConnectionFactory: redisConnectionFactory}
+}
+
+func (checker redisHealthChecker) Id() string {
+	return HealthCheckerRedis
+}
+
+func (checker redisHealthChecker) Check(
+	ctx context.Context,
+) error {
+	var errs []error
+	for _, id := range checker.redisConnectionFactory.Stored() {
+		if e := checker.ping(ctx, id); e != nil {
+			errs = append(errs, newErrHealthCheckFailed("RedisConnection", id, e))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (checker redisHealthChecker) ping(
+	ctx context.Context,
+	id string,
+) error {
+	connection, e := checker.redisConnectionFactory.Get(id)
+	if e != nil {
+		return e
+	}
+
+	return connection.Ping(ctx).Err()
+}
@@ -0,0 +1,15 @@
+package flam
+
+import "time"
+
+type HealthCheckReport struct {
+	Id       string
+	Status   HealthStatus
+	Duration time.Duration
+	Error    error
+}
+
+type HealthReport struct {
+	Status HealthStatus
+	Checks []HealthCheckReport
+}
@@ -0,0 +1,19 @@
+package flam
+
+type HealthStatus int
+
+const (
+	HealthStatusUp HealthStatus = iota
+	HealthStatusDown
+)
+
+func (status HealthStatus) String() string {
+	switch status {
+	case HealthStatusUp:
+		return "up"
+	case HealthStatusDown:
+		return "down"
+	default:
+		return "unknown"
+	}
+}
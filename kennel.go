@@ -1,6 +1,7 @@
 package flam
 
 import (
+	"context"
 	"io"
 	"sync"
 
@@ -13,6 +14,7 @@ type Kennel interface {
 	Available() []string
 	Has(id string) bool
 	IsActive(id string) bool
+	IsRunning(id string) bool
 	Activate(id string) error
 	Deactivate(id string) error
 }
@@ -28,6 +30,7 @@ type kennel struct {
 	mu                    sync.Mutex
 	config                Config
 	watchdogLoggerFactory WatchdogLoggerFactory
+	pubSub                PubSub[string, string]
 	regs                  map[string]kennelReg
 }
 
@@ -39,33 +42,18 @@ func newKennel(args struct {
 	Config                Config
 	Processes             []Process `group:"flam.process"`
 	WatchdogLoggerFactory WatchdogLoggerFactory
+	PubSub                PubSub[string, string]
 }) (*kennel, error) {
 	kennel := &kennel{
 		config:                args.Config,
 		watchdogLoggerFactory: args.WatchdogLoggerFactory,
+		pubSub:                args.PubSub,
 		regs:                  map[string]kennelReg{}}
 
-	factoryConfig := args.Config.Bag(PathProcesses, Bag{})
-
 	for _, process := range args.Processes {
-		id := process.Id()
-		processConfig := factoryConfig.Bag(id, Bag{})
-
-		var watchdogLogger WatchdogLogger
-		loggerId := processConfig.String("logger_id", args.Config.String(DefaultWatchdogLoggerId))
-		if loggerId != "" {
-			logger, e := args.WatchdogLoggerFactory.Get(loggerId)
-			if e != nil {
-				return nil, e
-			}
-			watchdogLogger = logger
+		if e := kennel.add(process); e != nil {
+			return nil, e
 		}
-
-		kennel.regs[id] = kennelReg{
-			config:         processConfig,
-			watchdogLogger: watchdogLogger,
-			process:        process,
-			watchdog:       nil}
 	}
 
 	return kennel, nil
@@ -120,6 +108,20 @@ func (kennel *kennel) IsActive(
 	return reg.config.Bool("active", false)
 }
 
+func (kennel *kennel) IsRunning(
+	id string,
+) bool {
+	kennel.mu.Lock()
+	defer kennel.mu.Unlock()
+
+	reg, ok := kennel.regs[id]
+	if !ok {
+		return false
+	}
+
+	return reg.process.IsRunning()
+}
+
 func (kennel *kennel) Activate(
 	id string,
 ) error {
@@ -154,7 +156,38 @@ func (kennel *kennel) Deactivate(
 	return reg.config.Set("active", false)
 }
 
-func (kennel *kennel) run() error {
+func (kennel *kennel) add(
+	process Process,
+) error {
+	kennel.mu.Lock()
+	defer kennel.mu.Unlock()
+
+	id := process.Id()
+	factoryConfig := kennel.config.Bag(PathProcesses, Bag{})
+	processConfig := factoryConfig.Bag(id, Bag{})
+
+	var watchdogLogger WatchdogLogger
+	loggerId := processConfig.String("logger_id", kennel.config.String(DefaultWatchdogLoggerId))
+	if loggerId != "" {
+		logger, e := kennel.watchdogLoggerFactory.Get(loggerId)
+		if e != nil {
+			return e
+		}
+		watchdogLogger = logger
+	}
+
+	kennel.regs[id] = kennelReg{
+		config:         processConfig,
+		watchdogLogger: watchdogLogger,
+		process:        process,
+		watchdog:       nil}
+
+	return nil
+}
+
+func (kennel *kennel) run(
+	ctx context.Context,
+) error {
 	if !kennel.config.Bool(PathKennelRun, false) {
 		return nil
 	}
@@ -169,7 +202,7 @@ func (kennel *kennel) run() error {
 			continue
 		}
 
-		wd := newWatchdog(reg.process, reg.watchdogLogger)
+		wd := newWatchdog(id, reg.process, reg.watchdogLogger, kennel.pubSub)
 		kennel.regs[id] = kennelReg{
 			config:         reg.config,
 			watchdogLogger: reg.watchdogLogger,
@@ -191,6 +224,18 @@ func (kennel *kennel) run() error {
 		close(errorChan)
 	}()
 
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// Error ignored - watchdog termination is cleanup, the run result is reported by the watchdogs
+			_ = kennel.Close()
+		case <-done:
+		}
+	}()
+
 	for e := range errorChan {
 		result = e
 	}
@@ -32,15 +32,19 @@ type loggerEntryReg struct {
 }
 
 type logger struct {
-	mu      sync.Mutex
-	streams map[string]LogStream
-	buffer  []loggerEntryReg
+	mu       sync.Mutex
+	redactor *configRedactor
+	streams  map[string]LogStream
+	buffer   []loggerEntryReg
 }
 
-func newLogger() *logger {
+func newLogger(
+	redactor *configRedactor,
+) *logger {
 	return &logger{
-		streams: map[string]LogStream{},
-		buffer:  []loggerEntryReg{}}
+		redactor: redactor,
+		streams:  map[string]LogStream{},
+		buffer:   []loggerEntryReg{}}
 }
 
 func (logger *logger) Close() error {
@@ -65,8 +69,8 @@ func (logger *logger) Signal(
 		timestamp: time.Now(),
 		level:     level,
 		channel:   channel,
-		message:   message,
-		ctx:       context})
+		message:   logger.redactor.message(message),
+		ctx:       logger.redactor.context(context)})
 }
 
 func (logger *logger) SignalFatal(
@@ -134,8 +138,8 @@ func (logger *logger) Broadcast(
 		timestamp: time.Now(),
 		level:     level,
 		channel:   "",
-		message:   message,
-		ctx:       context})
+		message:   logger.redactor.message(message),
+		ctx:       logger.redactor.context(context)})
 }
 
 func (logger *logger) BroadcastFatal(
@@ -1,5 +1,7 @@
 package flam
 
+import "context"
+
 type logBooter struct {
 	config           Config
 	logStreamFactory LogStreamFactory
@@ -14,13 +16,18 @@ func newLogBooter(
 		logStreamFactory: logStreamFactory}
 }
 
-func (booter *logBooter) Boot() error {
+func (booter *logBooter) Boot(
+	ctx context.Context,
+) error {
 	if !booter.config.Bool(PathLogBoot) {
 		return nil
 	}
 
 	for id := range booter.config.Bag(PathLogStreams) {
-		_, e := booter.logStreamFactory.Get(id)
+		e := withContext(ctx, func() error {
+			_, e := booter.logStreamFactory.Get(id)
+			return e
+		})
 		if e != nil {
 			return e
 		}
@@ -22,8 +22,14 @@ func newLogFlusher(
 }
 
 func (flusher *logFlusher) Close() error {
+	// Error ignored - observer removal is cleanup, shouldn't block flusher close
+	_ = flusher.config.RemoveObserver("flam.log")
+
 	if flusher.trigger != nil {
-		return flusher.trigger.Close()
+		trigger := flusher.trigger
+		flusher.trigger = nil
+
+		return trigger.Close()
 	}
 
 	return nil
@@ -23,6 +23,7 @@ type logSerializerFactoryArgs struct {
 
 	Creators      []LogSerializerCreator `group:"flam.log.serializers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newLogSerializerFactory(
@@ -37,5 +38,6 @@ func newLogSerializerFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("LogSerializer"),
-		PathLogSerializers)
+		PathLogSerializers,
+		args.PubSub)
 }
@@ -28,6 +28,7 @@ type logSteamFactoryArgs struct {
 
 	Creators      []LogStreamCreator `group:"flam.log.streams.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 	Logger        *logger
 }
 
@@ -43,7 +44,8 @@ func newLogStreamFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("LogStream"),
-		PathLogStreams)
+		PathLogStreams,
+		args.PubSub)
 
 	return &logStreamFactory{
 		factory: f.(*factory[LogStream]),
@@ -51,7 +53,15 @@ func newLogStreamFactory(
 }
 
 func (factory logStreamFactory) Close() error {
-	return factory.factory.Close()
+	e := factory.factory.Close()
+	if e == nil {
+		factory.factory.locker.Lock()
+		defer factory.factory.locker.Unlock()
+
+		factory.logger.streams = factory.factory.entries
+	}
+
+	return e
 }
 
 func (factory logStreamFactory) Available() []string {
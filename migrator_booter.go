@@ -1,5 +1,7 @@
 package flam
 
+import "context"
+
 type migratorBooter struct {
 	config          Config
 	migratorFactory MigratorFactory
@@ -14,13 +16,20 @@ func newMigratorBooter(
 		migratorFactory: migratorFactory}
 }
 
-func (booter *migratorBooter) Boot() error {
+func (booter *migratorBooter) Boot(
+	ctx context.Context,
+) error {
 	if !booter.config.Bool(PathMigratorBoot) {
 		return nil
 	}
 
 	for _, id := range booter.migratorFactory.Available() {
-		migrator, e := booter.migratorFactory.Get(id)
+		var migrator Migrator
+		e := withContext(ctx, func() error {
+			var e error
+			migrator, e = booter.migratorFactory.Get(id)
+			return e
+		})
 		if e != nil {
 			return e
 		}
@@ -8,20 +8,30 @@ import (
 	"gorm.io/gorm"
 )
 
+type defaultMigratorEvent struct {
+	channel string
+	info    MigrationInfo
+}
+
 type defaultMigrator struct {
 	mu         sync.Mutex
+	id         string
 	connection DatabaseConnection
 	logger     MigratorLogger
 	migrations []Migration
 	dao        *migrationDao
+	pubSub     PubSub[string, string]
+	events     []defaultMigratorEvent
 }
 
 var _ Migrator = (*defaultMigrator)(nil)
 
 func newDefaultMigrator(
+	id string,
 	connection DatabaseConnection,
 	logger MigratorLogger,
 	migrations []Migration,
+	pubSub PubSub[string, string],
 ) (Migrator, error) {
 	dao, e := newMigrationDao(connection)
 	if e != nil {
@@ -29,10 +39,12 @@ func newDefaultMigrator(
 	}
 
 	return &defaultMigrator{
+		id:         id,
 		connection: connection,
 		logger:     logger,
 		migrations: migrations,
-		dao:        dao}, nil
+		dao:        dao,
+		pubSub:     pubSub}, nil
 }
 
 func (migrator *defaultMigrator) List() ([]MigrationInfo, error) {
@@ -134,7 +146,7 @@ func (migrator *defaultMigrator) CanDown() bool {
 
 func (migrator *defaultMigrator) Up() error {
 	migrator.mu.Lock()
-	defer migrator.mu.Unlock()
+	defer migrator.release()
 
 	last, e := migrator.dao.Last(migrator.connection)
 	if e != nil {
@@ -159,7 +171,7 @@ func (migrator *defaultMigrator) Up() error {
 
 func (migrator *defaultMigrator) UpAll() error {
 	migrator.mu.Lock()
-	defer migrator.mu.Unlock()
+	defer migrator.release()
 
 	last, e := migrator.dao.Last(migrator.connection)
 	if e != nil {
@@ -182,7 +194,7 @@ func (migrator *defaultMigrator) UpAll() error {
 
 func (migrator *defaultMigrator) Down() error {
 	migrator.mu.Lock()
-	defer migrator.mu.Unlock()
+	defer migrator.release()
 
 	last, e := migrator.dao.Last(migrator.connection)
 	if e != nil {
@@ -202,7 +214,7 @@ func (migrator *defaultMigrator) Down() error {
 
 func (migrator *defaultMigrator) DownAll() error {
 	migrator.mu.Lock()
-	defer migrator.mu.Unlock()
+	defer migrator.release()
 
 	if len(migrator.migrations) == 0 {
 		return nil
@@ -254,6 +266,7 @@ func (migrator *defaultMigrator) up(
 	}
 
 	migrator.logUpDone(migration)
+	migrator.record(EventMigrationApplied, migration)
 
 	return nil
 }
@@ -281,10 +294,32 @@ func (migrator *defaultMigrator) down(
 	}
 
 	migrator.logDownDone(migration)
+	migrator.record(EventMigrationReverted, migration)
 
 	return nil
 }
 
+func (migrator *defaultMigrator) record(
+	channel string,
+	migration Migration,
+) {
+	migrator.events = append(migrator.events, defaultMigratorEvent{
+		channel: channel,
+		info: MigrationInfo{
+			Version:     migration.Version(),
+			Description: migration.Description()}})
+}
+
+func (migrator *defaultMigrator) release() {
+	events := migrator.events
+	migrator.events = nil
+	migrator.mu.Unlock()
+
+	for _, event := range events {
+		publish(migrator.pubSub, event.channel, migrator.id, event.info)
+	}
+}
+
 func (migrator *defaultMigrator) logUpStart(
 	migration Migration,
 ) {
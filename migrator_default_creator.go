@@ -5,6 +5,7 @@ type defaultMigratorCreator struct {
 	databaseConnectionFactory DatabaseConnectionFactory
 	migrationLoggerFactory    MigratorLoggerFactory
 	migrationPool             *migrationPool
+	pubSub                    PubSub[string, string]
 }
 
 var _ MigratorCreator = (*defaultMigratorCreator)(nil)
@@ -14,12 +15,14 @@ func newDefaultMigratorCreator(
 	databaseConnectionFactory DatabaseConnectionFactory,
 	migrationLoggerFactory MigratorLoggerFactory,
 	migrationPool *migrationPool,
+	pubSub PubSub[string, string],
 ) MigratorCreator {
 	return &defaultMigratorCreator{
 		config:                    config,
 		databaseConnectionFactory: databaseConnectionFactory,
 		migrationLoggerFactory:    migrationLoggerFactory,
-		migrationPool:             migrationPool}
+		migrationPool:             migrationPool,
+		pubSub:                    pubSub}
 }
 
 func (creator defaultMigratorCreator) Accept(
@@ -58,7 +61,9 @@ func (creator defaultMigratorCreator) Create(
 	migrations := creator.migrationPool.Group(group)
 
 	return newDefaultMigrator(
+		config.String("id"),
 		connection,
 		logger,
-		migrations)
+		migrations,
+		creator.pubSub)
 }
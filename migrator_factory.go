@@ -23,6 +23,7 @@ type migratorFactoryArgs struct {
 
 	Creators      []MigratorCreator `group:"flam.migration.migrators.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newMigratorFactory(
@@ -37,5 +38,6 @@ func newMigratorFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("Migrator"),
-		PathMigrators)
+		PathMigrators,
+		args.PubSub)
 }
@@ -23,6 +23,7 @@ type migratorLoggerFactoryArgs struct {
 
 	Creators      []MigratorLoggerCreator `group:"flam.migration.loggers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newMigratorLoggerFactory(
@@ -37,5 +38,6 @@ func newMigratorLoggerFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("MigrationLogger"),
-		PathMigratorLoggers)
+		PathMigratorLoggers,
+		args.PubSub)
 }
@@ -0,0 +1,105 @@
+package flam
+
+import (
+	"fmt"
+	"strings"
+)
+
+type MultiErrorEntry struct {
+	Origin string
+	Err    error
+}
+
+func (entry MultiErrorEntry) Error() string {
+	if entry.Origin == "" {
+		return entry.Err.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", entry.Origin, entry.Err.Error())
+}
+
+type MultiError struct {
+	kind    error
+	entries []MultiErrorEntry
+}
+
+var _ error = (*MultiError)(nil)
+
+func NewMultiError(
+	kind error,
+) *MultiError {
+	return &MultiError{
+		kind: kind}
+}
+
+func (e *MultiError) Add(
+	origin string,
+	err error,
+) *MultiError {
+	if err == nil {
+		return e
+	}
+
+	e.entries = append(e.entries, MultiErrorEntry{
+		Origin: origin,
+		Err:    err})
+
+	return e
+}
+
+func (e *MultiError) Len() int {
+	return len(e.entries)
+}
+
+func (e *MultiError) Entries() []MultiErrorEntry {
+	return append([]MultiErrorEntry{}, e.entries...)
+}
+
+func (e *MultiError) Origin(
+	origin string,
+) []error {
+	var errs []error
+	for _, entry := range e.entries {
+		if entry.Origin == origin {
+			errs = append(errs, entry.Err)
+		}
+	}
+
+	return errs
+}
+
+func (e *MultiError) ErrorOrNil() error {
+	if e == nil || len(e.entries) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+func (e *MultiError) Error() string {
+	var lines []string
+	for _, entry := range e.entries {
+		lines = append(lines, entry.Error())
+	}
+
+	if e.kind == nil {
+		return strings.Join(lines, "\n")
+	}
+
+	return fmt.Sprintf("%s:\n%s", e.kind.Error(), strings.Join(lines, "\n"))
+}
+
+func (e *MultiError) Is(
+	target error,
+) bool {
+	return e.kind != nil && e.kind == target
+}
+
+func (e *MultiError) Unwrap() []error {
+	var errs []error
+	for _, entry := range e.entries {
+		errs = append(errs, entry.Err)
+	}
+
+	return errs
+}
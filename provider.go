@@ -1,6 +1,10 @@
 package flam
 
 import (
+	"context"
+	"slices"
+	"time"
+
 	"go.uber.org/dig"
 )
 
@@ -15,41 +19,96 @@ type ConfigurableProvider interface {
 	Config(config *Bag) error
 }
 
+type ConfigSchemaProvider interface {
+	ConfigurableProvider
+
+	ConfigSchema() ConfigSchema
+}
+
+type DependentProvider interface {
+	Provider
+
+	Dependencies() []string
+}
+
 type BootableProvider interface {
 	Provider
 
 	Boot(container *dig.Container) error
 }
 
+type ContextBootableProvider interface {
+	Provider
+
+	BootContext(ctx context.Context, container *dig.Container) error
+}
+
 type RunnableProvider interface {
 	Provider
 
 	Run(container *dig.Container) error
 }
 
+type ContextRunnableProvider interface {
+	Provider
+
+	RunContext(ctx context.Context, container *dig.Container) error
+}
+
 type ClosableProvider interface {
 	Provider
 
 	Close(container *dig.Container) error
 }
 
-type provider struct{}
+type ContextClosableProvider interface {
+	Provider
+
+	CloseContext(ctx context.Context, container *dig.Container) error
+}
+
+type provider struct {
+	database  bool
+	migrator  bool
+	redis     bool
+	cache     bool
+	validator bool
+	kennel    bool
+}
 
 var _ Provider = (*provider)(nil)
+var _ ConfigurableProvider = (*provider)(nil)
+var _ ConfigSchemaProvider = (*provider)(nil)
+var _ BootableProvider = (*provider)(nil)
+var _ ContextBootableProvider = (*provider)(nil)
+var _ RunnableProvider = (*provider)(nil)
+var _ ContextRunnableProvider = (*provider)(nil)
 var _ ClosableProvider = (*provider)(nil)
+var _ ContextClosableProvider = (*provider)(nil)
+
+func newProvider(
+	config Bag,
+) Provider {
+	database := config.Bool(PathSubsystemDatabase, DefaultSubsystemDatabase)
+	redis := config.Bool(PathSubsystemRedis, DefaultSubsystemRedis)
 
-func newProvider() Provider {
-	return &provider{}
+	return &provider{
+		database:  database,
+		migrator:  database && config.Bool(PathSubsystemMigrator, DefaultSubsystemMigrator),
+		redis:     redis,
+		cache:     config.Bool(PathSubsystemCache, DefaultSubsystemCache),
+		validator: config.Bool(PathSubsystemValidator, DefaultSubsystemValidator),
+		kennel:    config.Bool(PathSubsystemKennel, DefaultSubsystemKennel)}
 }
 
 func (*provider) Id() string {
 	return providerId
 }
 
-func (*provider) Register(
+func (provider *provider) Register(
 	container *dig.Container,
 ) error {
-	return NewRegisterer().
+	registerer := NewRegisterer().
 		Queue(func() PubSub[string, string] { return NewPubSub[string, string]() }).
 		Queue(newTimer).
 		Queue(newTriggerFactory).
@@ -62,16 +121,24 @@ func (*provider) Register(
 		Queue(newYamlConfigParserCreator, dig.Group(ConfigParserCreatorGroup)).
 		Queue(newConfigSourceFactory).
 		Queue(newEnvConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
+		Queue(newFlagsConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
 		Queue(newFileConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
 		Queue(newObservableFileConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
 		Queue(newDirConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
+		Queue(newObservableDirConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
 		Queue(newRestConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
 		Queue(newObservableRestConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
+		Queue(newEnvConfigSecretResolver, dig.Group(ConfigSecretResolverGroup)).
+		Queue(newFileConfigSecretResolver, dig.Group(ConfigSecretResolverGroup)).
+		Queue(newConfigRedactor).
 		Queue(newConfig).
 		Queue(func(config *config) Config { return config }).
+		Queue(newConfigWatcher).
 		Queue(newConfigObserver).
 		Queue(newConfigBooter).
+		Queue(newConfigValidator).
 		Queue(newFactoryConfig).
+		Queue(newWarmupBooter).
 		Queue(newLogSerializerFactory).
 		Queue(newStringLogSerializerCreator, dig.Group(LogSerializerCreatorGroup)).
 		Queue(newJsonLogSerializerCreator, dig.Group(LogSerializerCreatorGroup)).
@@ -83,55 +150,110 @@ func (*provider) Register(
 		Queue(func(logger *logger) Logger { return logger }).
 		Queue(newLogFlusher).
 		Queue(newLogBooter).
-		Queue(newDatabaseConfigFactory).
-		Queue(newDefaultDatabaseConfigCreator, dig.Group(DatabaseConfigCreatorGroup)).
-		Queue(newDatabaseDialectFactory).
-		Queue(newSqliteDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
-		Queue(newMysqlDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
-		Queue(newPostgresDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
-		Queue(newDatabaseConnectionFactory).
-		Queue(newDatabaseConnectionCreator).
-		Queue(newMigrationPool).
-		Queue(newMigrationDao).
-		Queue(newMigratorLoggerFactory).
-		Queue(newDefaultMigratorLoggerCreator, dig.Group(MigratorLoggerCreatorGroup)).
-		Queue(newMigratorFactory).
-		Queue(newDefaultMigratorCreator, dig.Group(MigratorCreatorGroup)).
-		Queue(newMigratorBooter).
-		Queue(newRedisConnectionFactory).
-		Queue(newDefaultRedisConnectionCreator, dig.Group(RedisConnectionCreatorGroup)).
-		Queue(newMiniRedisConnectionCreator, dig.Group(RedisConnectionCreatorGroup)).
-		Queue(newRedisBooter).
-		Queue(newCacheSerializerFactory).
-		Queue(newCacheKeyGeneratorFactory).
-		Queue(newCacheAdaptorFactory).
-		Queue(newRedisCacheAdaptorCreator, dig.Group(CacheAdaptorCreatorGroup)).
-		Queue(newTranslatorFactory).
-		Queue(newEnglishTranslatorCreator, dig.Group(TranslatorCreatorGroup)).
-		Queue(newValidatorParserFactory).
-		Queue(newDefaultValidatorParserCreator, dig.Group(ValidatorParserCreatorGroup)).
-		Queue(newValidatorErrorConverterFactory).
-		Queue(newValidatorFactory).
-		Queue(newDefaultValidatorCreator, dig.Group(ValidatorCreatorGroup)).
-		Queue(newWatchdogLoggerFactory).
-		Queue(newDefaultWatchdogLoggerCreator, dig.Group(WatchdogLoggerCreatorGroup)).
-		Queue(newKennel).
-		Queue(func(kennel *kennel) Kennel { return kennel }).
-		Run(container)
+		Queue(newHealth).
+		Queue(func(health *health) Health { return health }).
+		Queue(newCommander).
+		Queue(func(commander *commander) Commander { return commander }).
+		Queue(newConfigSourcesCommand, dig.Group(CommandGroup)).
+		Queue(newConfigDumpCommand, dig.Group(CommandGroup)).
+		Queue(newConfigEncryptCommand, dig.Group(CommandGroup))
+
+	if provider.database {
+		registerer.
+			Queue(newDatabaseConfigFactory).
+			Queue(newDefaultDatabaseConfigCreator, dig.Group(DatabaseConfigCreatorGroup)).
+			Queue(newDatabaseDialectFactory).
+			Queue(newSqliteDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
+			Queue(newMysqlDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
+			Queue(newPostgresDatabaseDialectCreator, dig.Group(DatabaseDialectCreatorGroup)).
+			Queue(newDatabaseConnectionFactory).
+			Queue(newDatabaseConnectionCreator).
+			Queue(newDatabaseHealthChecker, dig.Group(HealthCheckerGroup)).
+			Queue(newDatabaseConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup))
+	}
+
+	if provider.migrator {
+		registerer.
+			Queue(newMigrationPool).
+			Queue(newMigratorLoggerFactory).
+			Queue(newDefaultMigratorLoggerCreator, dig.Group(MigratorLoggerCreatorGroup)).
+			Queue(newMigratorFactory).
+			Queue(newDefaultMigratorCreator, dig.Group(MigratorCreatorGroup)).
+			Queue(newMigratorBooter).
+			Queue(newMigratorListCommand, dig.Group(CommandGroup)).
+			Queue(newMigratorUpCommand, dig.Group(CommandGroup)).
+			Queue(newMigratorUpAllCommand, dig.Group(CommandGroup)).
+			Queue(newMigratorDownCommand, dig.Group(CommandGroup)).
+			Queue(newMigratorDownAllCommand, dig.Group(CommandGroup))
+	}
+
+	if provider.redis {
+		registerer.
+			Queue(newRedisConnectionFactory).
+			Queue(newDefaultRedisConnectionCreator, dig.Group(RedisConnectionCreatorGroup)).
+			Queue(newMiniRedisConnectionCreator, dig.Group(RedisConnectionCreatorGroup)).
+			Queue(newRedisConfigSourceCreator, dig.Group(ConfigSourceCreatorGroup)).
+			Queue(newRedisBooter).
+			Queue(newRedisHealthChecker, dig.Group(HealthCheckerGroup))
+	}
+
+	if provider.cache {
+		registerer.
+			Queue(newCacheSerializerFactory).
+			Queue(newCacheKeyGeneratorFactory).
+			Queue(newCacheAdaptorFactory)
+		if provider.redis {
+			registerer.Queue(newRedisCacheAdaptorCreator, dig.Group(CacheAdaptorCreatorGroup))
+		}
+	}
+
+	if provider.validator {
+		registerer.
+			Queue(newTranslatorFactory).
+			Queue(newEnglishTranslatorCreator, dig.Group(TranslatorCreatorGroup)).
+			Queue(newValidatorParserFactory).
+			Queue(newDefaultValidatorParserCreator, dig.Group(ValidatorParserCreatorGroup)).
+			Queue(newValidatorErrorConverterFactory).
+			Queue(newValidatorFactory).
+			Queue(newDefaultValidatorCreator, dig.Group(ValidatorCreatorGroup))
+	}
+
+	if provider.kennel {
+		registerer.
+			Queue(newWatchdogLoggerFactory).
+			Queue(newDefaultWatchdogLoggerCreator, dig.Group(WatchdogLoggerCreatorGroup)).
+			Queue(newKennel).
+			Queue(func(kennel *kennel) Kennel { return kennel }).
+			Queue(newKennelHealthChecker, dig.Group(HealthCheckerGroup)).
+			Queue(newAdminProcess).
+			Queue(func(adminProcess *adminProcess) AdminProcess { return adminProcess }).
+			Queue(newAdminBooter).
+			Queue(newKennelProcessesCommand, dig.Group(CommandGroup))
+	}
+
+	return registerer.Run(container)
 }
 
-func (*provider) Config(
+func (provider *provider) Config(
 	config *Bag,
 ) error {
 	// Errors ignored - setting default values, shouldn't block configuration
 	_ = config.Set(PathConfigBoot, DefaultConfigBoot)
 	_ = config.Set(PathConfigObserverFrequency, DefaultConfigObserverFrequency)
+	_ = config.Set(PathConfigWatcherEnabled, DefaultConfigWatcherEnabled)
+	_ = config.Set(PathConfigWatcherDebounce, DefaultConfigWatcherDebounce)
 	_ = config.Set(PathConfigDefaultFileParserId, DefaultConfigFileParserId)
 	_ = config.Set(PathConfigDefaultFileDiskId, DefaultConfigFileDiskId)
 	_ = config.Set(PathConfigDefaultRestParserId, DefaultConfigRestParserId)
 	_ = config.Set(PathConfigDefaultRestConfigPath, DefaultConfigRestConfigPath)
 	_ = config.Set(PathConfigDefaultRestTimestampPath, DefaultConfigRestTimestampPath)
+	_ = config.Set(PathConfigDefaultRestTimeout, DefaultConfigRestTimeout)
+	_ = config.Set(PathConfigDefaultRestRetryAttempts, DefaultConfigRestRetryAttempts)
+	_ = config.Set(PathConfigDefaultRestRetryBackoff, DefaultConfigRestRetryBackoff)
+	_ = config.Set(PathConfigDefaultDatabaseTable, DefaultConfigDatabaseTable)
 	_ = config.Set(PathConfigDefaultPriority, DefaultConfigPriority)
+	_ = config.Set(PathConfigDefaultEnvSeparator, DefaultConfigEnvSeparator)
+	_ = config.Set(PathConfigSecretDiskId, DefaultConfigSecretDiskId)
 
 	_ = config.Set(PathLogBoot, DefaultLogBoot)
 	_ = config.Set(PathLogFlusherFrequency, DefaultLogFlusherFrequency)
@@ -177,102 +299,266 @@ func (*provider) Config(
 	_ = config.Set(PathWatchdogDefaultLoggerErrorLevel, DefaultWatchdogLoggerErrorLevel)
 	_ = config.Set(PathWatchdogDefaultLoggerDoneLevel, DefaultWatchdogLoggerDoneLevel)
 
+	_ = config.Set(PathHealthTimeout, DefaultHealthTimeout)
+
+	_ = config.Set(PathAdminBoot, DefaultAdminBoot)
+	_ = config.Set(PathAdminAddress, DefaultAdminAddress)
+	_ = config.Set(PathConfigRedact, slices.Clone(configRedactDefaultKeys))
+
+	_ = config.Set(PathShutdownTimeout, DefaultShutdownTimeout)
+	_ = config.Set(PathContainerVerify, DefaultContainerVerify)
+	_ = config.Set(PathFactoryWarmup, DefaultFactoryWarmup)
+
+	_ = config.Set(PathSubsystemDatabase, provider.database)
+	_ = config.Set(PathSubsystemMigrator, provider.migrator)
+	_ = config.Set(PathSubsystemRedis, provider.redis)
+	_ = config.Set(PathSubsystemCache, provider.cache)
+	_ = config.Set(PathSubsystemValidator, provider.validator)
+	_ = config.Set(PathSubsystemKennel, provider.kennel)
+
 	return nil
 }
 
+func (*provider) ConfigSchema() ConfigSchema {
+	return ConfigSchema{
+		{Path: PathConfigBoot, Type: ConfigTypeBool},
+		{Path: PathConfigObserverFrequency, Type: ConfigTypeDuration, Min: time.Millisecond},
+		{Path: PathConfigWatcherEnabled, Type: ConfigTypeBool},
+		{Path: PathConfigWatcherDebounce, Type: ConfigTypeDuration, Min: 0},
+		{Path: PathConfigDefaultPriority, Type: ConfigTypeInt},
+		{Path: PathConfigDefaultRestTimeout, Type: ConfigTypeDuration, Min: 0},
+		{Path: PathConfigDefaultRestRetryAttempts, Type: ConfigTypeInt, Min: 1},
+		{Path: PathConfigDefaultRestRetryBackoff, Type: ConfigTypeDuration, Min: 0},
+		{Path: PathConfigDefaultDatabaseTable, Type: ConfigTypeString, Min: 1},
+		{Path: PathLogBoot, Type: ConfigTypeBool},
+		{Path: PathLogFlusherFrequency, Type: ConfigTypeDuration, Min: time.Millisecond},
+		{Path: PathDatabaseDefaultMySqlPort, Type: ConfigTypeInt, Min: 1, Max: 65535},
+		{Path: PathDatabaseDefaultPostgresPort, Type: ConfigTypeInt, Min: 1, Max: 65535},
+		{Path: PathMigratorBoot, Type: ConfigTypeBool},
+		{Path: PathRedisMiniBoot, Type: ConfigTypeBool},
+		{Path: PathRedisDefaultPort, Type: ConfigTypeInt, Min: 1, Max: 65535},
+		{Path: PathRedisDefaultDatabase, Type: ConfigTypeInt, Min: 0},
+		{Path: PathKennelRun, Type: ConfigTypeBool},
+		{Path: PathHealthTimeout, Type: ConfigTypeDuration, Min: 0},
+		{Path: PathAdminBoot, Type: ConfigTypeBool},
+		{Path: PathAdminAddress, Type: ConfigTypeString},
+		{Path: PathConfigRedact, Type: ConfigTypeList},
+		{Path: PathShutdownTimeout, Type: ConfigTypeDuration, Min: 0},
+		{Path: PathContainerVerify, Type: ConfigTypeBool},
+		{Path: PathFactoryWarmup, Type: ConfigTypeBool},
+		{Path: PathSubsystemDatabase, Type: ConfigTypeBool},
+		{Path: PathSubsystemMigrator, Type: ConfigTypeBool},
+		{Path: PathSubsystemRedis, Type: ConfigTypeBool},
+		{Path: PathSubsystemCache, Type: ConfigTypeBool},
+		{Path: PathSubsystemValidator, Type: ConfigTypeBool},
+		{Path: PathSubsystemKennel, Type: ConfigTypeBool}}
+}
+
 func (provider *provider) Boot(
 	container *dig.Container,
 ) error {
-	return NewExecutor().
-		Queue(provider.bootConfig).
-		Queue(provider.bootLog).
-		Queue(provider.bootMigrator).
-		Queue(provider.bootRedis).
-		Run(container)
+	return provider.BootContext(context.Background(), container)
+}
+
+func (provider *provider) BootContext(
+	ctx context.Context,
+	container *dig.Container,
+) error {
+	executor := NewExecutor().
+		Queue(provider.bootConfig(ctx)).
+		Queue(provider.bootLog(ctx))
+
+	if provider.redis {
+		executor.Queue(provider.bootRedis(ctx))
+	}
+
+	executor.Queue(provider.bootWarmup(ctx))
+
+	if provider.migrator {
+		executor.Queue(provider.bootMigrator(ctx))
+	}
+
+	if provider.kennel {
+		executor.Queue(provider.bootAdmin(ctx, container))
+	}
+
+	return executor.Run(container)
 }
 
 func (provider *provider) Run(
 	container *dig.Container,
 ) error {
-	return NewExecutor().
-		Queue(provider.runKennel).
-		Run(container)
+	return provider.RunContext(context.Background(), container)
 }
 
-func (provider *provider) bootConfig(
-	configBooter *configBooter,
-	configObserver *configObserver,
+func (provider *provider) RunContext(
+	ctx context.Context,
+	container *dig.Container,
 ) error {
-	var e error
-	exec := func(f func() error) bool {
-		e = f()
-		return e == nil
+	executor := NewExecutor()
+
+	if provider.kennel {
+		executor.Queue(provider.runKennel(ctx))
 	}
 
-	_ = exec(configBooter.Boot) &&
-		exec(configObserver.Boot)
+	return executor.Run(container)
+}
 
-	return e
+func (provider *provider) bootConfig(
+	ctx context.Context,
+) func(*configBooter, *configValidator, *configWatcher, *configObserver) error {
+	return func(
+		configBooter *configBooter,
+		configValidator *configValidator,
+		configWatcher *configWatcher,
+		configObserver *configObserver,
+	) error {
+		if e := configBooter.Boot(ctx); e != nil {
+			return e
+		}
+
+		if e := configValidator.Validate(); e != nil {
+			return e
+		}
+
+		if e := configWatcher.Boot(); e != nil {
+			return e
+		}
+
+		return configObserver.Boot()
+	}
 }
 
 func (provider *provider) bootLog(
-	logBooter *logBooter,
-	logFlusher *logFlusher,
-) error {
-	var e error
-	exec := func(f func() error) bool {
-		e = f()
-		return e == nil
+	ctx context.Context,
+) func(*logBooter, *logFlusher) error {
+	return func(
+		logBooter *logBooter,
+		logFlusher *logFlusher,
+	) error {
+		if e := logBooter.Boot(ctx); e != nil {
+			return e
+		}
+
+		return logFlusher.Boot()
 	}
+}
 
-	_ = exec(logBooter.Boot) &&
-		exec(logFlusher.Boot)
-
-	return e
+func (provider *provider) bootWarmup(
+	ctx context.Context,
+) func(*warmupBooter) error {
+	return func(
+		warmupBooter *warmupBooter,
+	) error {
+		return warmupBooter.Boot(ctx)
+	}
 }
 
 func (provider *provider) bootMigrator(
-	migratorBooter *migratorBooter,
-) error {
-	return migratorBooter.Boot()
+	ctx context.Context,
+) func(*migratorBooter) error {
+	return func(
+		migratorBooter *migratorBooter,
+	) error {
+		return migratorBooter.Boot(ctx)
+	}
 }
 
 func (provider *provider) bootRedis(
-	redisBooter *redisBooter,
-) error {
-	return redisBooter.Boot()
+	ctx context.Context,
+) func(*redisBooter) error {
+	return func(
+		redisBooter *redisBooter,
+	) error {
+		return redisBooter.Boot(ctx)
+	}
+}
+
+func (provider *provider) bootAdmin(
+	ctx context.Context,
+	container *dig.Container,
+) func(Config) error {
+	return func(
+		config Config,
+	) error {
+		if !config.Bool(PathAdminBoot) {
+			return nil
+		}
+
+		return container.Invoke(func(adminBooter *adminBooter) error {
+			return adminBooter.Boot(ctx)
+		})
+	}
 }
 
 func (provider *provider) runKennel(
-	kennel *kennel,
-) error {
-	return kennel.run()
+	ctx context.Context,
+) func(*kennel) error {
+	return func(
+		kennel *kennel,
+	) error {
+		return kennel.run(ctx)
+	}
 }
 
 func (provider *provider) Close(
 	container *dig.Container,
 ) error {
-	return NewExecutor().
-		Queue(provider.closeKennel).
-		Queue(provider.closeWatchdogLoggerFactory).
-		Queue(provider.closeValidatorFactory).
-		Queue(provider.closeValidatorErrorConverterFactory).
-		Queue(provider.closeValidatorParserFactory).
-		Queue(provider.closeTranslatorFactory).
-		Queue(provider.closeCacheAdaptorFactory).
-		Queue(provider.closeCacheSerializerFactory).
-		Queue(provider.closeCacheKeyGeneratorFactory).
-		Queue(provider.closeRedisConnectionFactory).
-		Queue(provider.closeRedisMini).
-		Queue(provider.closeMigratorFactory).
-		Queue(provider.closeMigratorLoggerFactory).
-		Queue(provider.closeDatabaseConnectionFactory).
-		Queue(provider.closeDatabaseDialectFactory).
+	return provider.CloseContext(context.Background(), container)
+}
+
+func (provider *provider) CloseContext(
+	ctx context.Context,
+	container *dig.Container,
+) error {
+	executor := NewExecutor()
+
+	if provider.kennel {
+		executor.
+			Queue(provider.closeKennel(ctx)).
+			Queue(provider.closeWatchdogLoggerFactory(ctx))
+	}
+
+	if provider.validator {
+		executor.
+			Queue(provider.closeValidatorFactory).
+			Queue(provider.closeValidatorErrorConverterFactory).
+			Queue(provider.closeValidatorParserFactory).
+			Queue(provider.closeTranslatorFactory)
+	}
+
+	if provider.cache {
+		executor.
+			Queue(provider.closeCacheAdaptorFactory).
+			Queue(provider.closeCacheSerializerFactory).
+			Queue(provider.closeCacheKeyGeneratorFactory)
+	}
+
+	if provider.redis {
+		executor.
+			Queue(provider.closeRedisConnectionFactory).
+			Queue(provider.closeRedisMini)
+	}
+
+	if provider.migrator {
+		executor.
+			Queue(provider.closeMigratorFactory).
+			Queue(provider.closeMigratorLoggerFactory)
+	}
+
+	if provider.database {
+		executor.
+			Queue(provider.closeDatabaseConnectionFactory).
+			Queue(provider.closeDatabaseDialectFactory)
+	}
+
+	return executor.
 		Queue(provider.closeLogFlusher).
 		Queue(provider.closeLogger).
 		Queue(provider.closeLogStreamFactory).
 		Queue(provider.closeLogSerializerFactory).
 		Queue(provider.closeConfigObserver).
+		Queue(provider.closeConfigWatcher).
 		Queue(provider.closeConfigSourceFactory).
 		Queue(provider.closeConfigParserFactory).
 		Queue(provider.closeDiskFactory).
@@ -280,15 +566,19 @@ func (provider *provider) Close(
 }
 
 func (*provider) closeWatchdogLoggerFactory(
-	watchdogLoggerFactory WatchdogLoggerFactory,
-) error {
-	return watchdogLoggerFactory.Close()
+	ctx context.Context,
+) func(WatchdogLoggerFactory) error {
+	return func(watchdogLoggerFactory WatchdogLoggerFactory) error {
+		return withContext(ctx, watchdogLoggerFactory.Close)
+	}
 }
 
 func (*provider) closeKennel(
-	kennel Kennel,
-) error {
-	return kennel.Close()
+	ctx context.Context,
+) func(Kennel) error {
+	return func(kennel Kennel) error {
+		return withContext(ctx, kennel.Close)
+	}
 }
 
 func (*provider) closeValidatorFactory(
@@ -393,6 +683,12 @@ func (provider *provider) closeLogSerializerFactory(
 	return logSerializerFactory.Close()
 }
 
+func (provider *provider) closeConfigWatcher(
+	configWatcher *configWatcher,
+) error {
+	return configWatcher.Close()
+}
+
 func (provider *provider) closeConfigObserver(
 	configObserver *configObserver,
 ) error {
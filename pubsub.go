@@ -1,6 +1,7 @@
 package flam
 
 import (
+	"fmt"
 	"sync"
 )
 
@@ -86,39 +87,32 @@ func (ps *pubsub[I, C]) Publish(
 		return nil
 	}
 
-	// Copy handlers to a slice to release the lock
-	handlers := make([]PubSubHandler[I, C], 0, len(subs))
-	for _, handler := range subs {
-		handlers = append(handlers, handler)
+	// Copy handlers to a map to release the lock
+	handlers := make(map[I]PubSubHandler[I, C], len(subs))
+	for id, handler := range subs {
+		handlers[id] = handler
 	}
 	ps.mu.Unlock()
 
 	// execute handlers asynchronously
 	var wg sync.WaitGroup
-	errorChan := make(chan error, len(handlers))
+	var errorsMu sync.Mutex
+	errs := NewMultiError(ErrPublishFailed)
 
-	for _, handler := range handlers {
+	for id, handler := range handlers {
 		wg.Add(1)
-		go func(h PubSubHandler[I, C]) {
+		go func(id I, h PubSubHandler[I, C]) {
 			defer wg.Done()
 			if e := h(channel, data...); e != nil {
-				errorChan <- e
+				errorsMu.Lock()
+				errs.Add(fmt.Sprintf("%v", id), e)
+				errorsMu.Unlock()
 			}
-		}(handler)
+		}(id, handler)
 	}
 
 	// wait for all handlers to complete
 	wg.Wait()
-	close(errorChan)
 
-	// collect errors
-	var errors []error
-	for e := range errorChan {
-		errors = append(errors, e)
-	}
-	if len(errors) > 0 {
-		return newErrPublishFailed(errors)
-	}
-
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -1,6 +1,7 @@
 package flam
 
 import (
+	"context"
 	"sync"
 
 	"github.com/alicebob/miniredis/v2"
@@ -31,7 +32,9 @@ func (booter *redisBooter) Close() error {
 	return nil
 }
 
-func (booter *redisBooter) Boot() error {
+func (booter *redisBooter) Boot(
+	ctx context.Context,
+) error {
 	booter.mu.Lock()
 	defer booter.mu.Unlock()
 
@@ -39,6 +42,10 @@ func (booter *redisBooter) Boot() error {
 		return nil
 	}
 
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+
 	booter.mini = miniredis.NewMiniRedis()
 	if e := booter.mini.Start(); e != nil {
 		return e
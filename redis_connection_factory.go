@@ -23,6 +23,7 @@ type redisConnectionFactoryArgs struct {
 
 	Creators      []RedisConnectionCreator `group:"flam.redis.connections.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newRedisConnectionFactory(
@@ -37,5 +38,6 @@ func newRedisConnectionFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("RedisConnection"),
-		PathRedisConnections)
+		PathRedisConnections,
+		args.PubSub)
 }
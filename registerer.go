@@ -49,9 +49,18 @@ func (registerer *registerer) Run(
 		return newErrNilReference("container")
 	}
 
+	var registry *containerRegistry
+	// Error ignored - the registry is only available on application managed containers
+	_ = container.Invoke(func(r *containerRegistry) { registry = r })
+
 	for _, entry := range registerer.entries {
-		if e := container.Provide(entry.constructor, entry.opts...); e != nil {
-			return e
+		info := dig.ProvideInfo{}
+		if e := container.Provide(entry.constructor, append(entry.opts, dig.FillProvideInfo(&info))...); e != nil {
+			return newErrRegistrationFailed(callbackName(entry.constructor), e)
+		}
+
+		if registry != nil {
+			registry.record(entry.constructor, info)
 		}
 	}
 
@@ -0,0 +1,452 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func adminRequest(
+	t *testing.T,
+	app flam.Application,
+	method string,
+	path string,
+	body string,
+) (int, any) {
+	var server *httptest.Server
+	require.NoError(t, app.Container().Invoke(func(admin flam.AdminProcess) {
+		server = httptest.NewServer(admin.Handler())
+	}))
+	defer server.Close()
+
+	request, e := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+	require.NoError(t, e)
+
+	response, e := http.DefaultClient.Do(request)
+	require.NoError(t, e)
+	defer func() { _ = response.Body.Close() }()
+
+	var result any
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&result))
+
+	return response.StatusCode, result
+}
+
+func Test_AdminProcess_Boot(t *testing.T) {
+	t.Run("should not register the admin process if not flagged to do so", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(kennel flam.Kennel) {
+			assert.False(t, kennel.Has(flam.AdminProcessId))
+		}))
+	})
+
+	t.Run("should register the admin process as an active process", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathAdminBoot, true)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(kennel flam.Kennel) {
+			assert.True(t, kennel.Has(flam.AdminProcessId))
+			assert.True(t, kennel.IsActive(flam.AdminProcessId))
+		}))
+	})
+}
+
+func Test_AdminProcess_Run(t *testing.T) {
+	t.Run("should return the server listening error", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathAdminAddress, "invalid-address")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(admin flam.AdminProcess) {
+			assert.Error(t, admin.Run())
+			assert.False(t, admin.IsRunning())
+		}))
+	})
+
+	t.Run("should serve until terminated", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathAdminAddress, "127.0.0.1:0")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(admin flam.AdminProcess) {
+			assert.Equal(t, flam.AdminProcessId, admin.Id())
+
+			go func() {
+				time.Sleep(20 * time.Millisecond)
+				assert.True(t, admin.IsRunning())
+				admin.Terminate()
+			}()
+
+			assert.NoError(t, admin.Run())
+			assert.False(t, admin.IsRunning())
+		}))
+	})
+}
+
+func Test_AdminProcess_Health(t *testing.T) {
+	t.Run("should return the health report", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/health", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, "up", body.(map[string]any)["status"])
+		assert.Len(t, body.(map[string]any)["checks"], 3)
+	})
+
+	t.Run("should return service unavailable if any check fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		checkerMock.EXPECT().Check(gomock.Any()).Return(errors.New("check error"))
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/health", "")
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		assert.Equal(t, "down", body.(map[string]any)["status"])
+
+		check := body.(map[string]any)["checks"].([]any)[0].(map[string]any)
+		assert.Equal(t, "custom", check["id"])
+		assert.Equal(t, "down", check["status"])
+		assert.Equal(t, "check error", check["error"])
+	})
+}
+
+func Test_AdminProcess_Config(t *testing.T) {
+	t.Run("should return the redacted aggregated config", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set("app.name", "my_app")
+		_ = config.Set("app.database.password", "my_password")
+		_ = config.Set("app.api_token", "my_token")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/config", "")
+		assert.Equal(t, http.StatusOK, status)
+
+		data := body.(map[string]any)["app"].(map[string]any)
+		assert.Equal(t, "my_app", data["name"])
+		assert.Equal(t, flam.ConfigRedactedValue, data["database"].(map[string]any)["password"])
+		assert.Equal(t, flam.ConfigRedactedValue, data["api_token"])
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "my_password", config.Get("app.database.password"))
+		}))
+	})
+}
+
+func Test_AdminProcess_LogStreams(t *testing.T) {
+	t.Run("should list the stored log streams", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		streamMock := mocks.NewMockLogStream(ctrl)
+		streamMock.EXPECT().GetLevel().Return(flam.LogInfo)
+		streamMock.EXPECT().ListChannels().Return([]string{"flam"})
+		streamMock.EXPECT().Close().Return(nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.LogStreamFactory) {
+			require.NoError(t, factory.Store("my_stream", streamMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/log/streams", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []any{map[string]any{
+			"id":       "my_stream",
+			"level":    "info",
+			"channels": []any{"flam"}}}, body)
+	})
+
+	t.Run("should return bad request on invalid level body", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodPut, "/log/streams/my_stream/level", "{")
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("should return bad request on unknown level", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodPut, "/log/streams/my_stream/level", `{"level": "verbose"}`)
+		assert.Equal(t, http.StatusBadRequest, status)
+	})
+
+	t.Run("should return not found on unknown stream", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodPut, "/log/streams/my_stream/level", `{"level": "debug"}`)
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("should update the stream level", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		streamMock := mocks.NewMockLogStream(ctrl)
+		streamMock.EXPECT().SetLevel(flam.LogDebug).Return(nil)
+		streamMock.EXPECT().GetLevel().Return(flam.LogDebug)
+		streamMock.EXPECT().Close().Return(nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.LogStreamFactory) {
+			require.NoError(t, factory.Store("my_stream", streamMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodPut, "/log/streams/my_stream/level", `{"level": "debug"}`)
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{"id": "my_stream", "level": "debug"}, body)
+	})
+}
+
+func Test_AdminProcess_Processes(t *testing.T) {
+	t.Run("should list the kennel processes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().IsRunning().Return(false)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/processes", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []any{map[string]any{
+			"id":      "process_id",
+			"active":  true,
+			"running": false}}, body)
+	})
+
+	t.Run("should return not found when activating an unknown process", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodPost, "/processes/process_id/activate", "")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("should return conflict when deactivating a running process", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().IsRunning().Return(true)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodPost, "/processes/process_id/deactivate", "")
+		assert.Equal(t, http.StatusConflict, status)
+	})
+
+	t.Run("should activate and deactivate a process", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().IsRunning().Return(false).Times(2)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodPost, "/processes/process_id/activate", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{"id": "process_id", "active": true}, body)
+		assert.NoError(t, app.Container().Invoke(func(kennel flam.Kennel) {
+			assert.True(t, kennel.IsActive("process_id"))
+		}))
+
+		status, body = adminRequest(t, app, http.MethodPost, "/processes/process_id/deactivate", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, map[string]any{"id": "process_id", "active": false}, body)
+		assert.NoError(t, app.Container().Invoke(func(kennel flam.Kennel) {
+			assert.False(t, kennel.IsActive("process_id"))
+		}))
+	})
+}
+
+func Test_AdminProcess_Migrators(t *testing.T) {
+	t.Run("should serve an empty migrator list when the migrator subsystem is disabled", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathSubsystemMigrator, false)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, result := adminRequest(t, app, http.MethodGet, "/migrators", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Nil(t, result)
+
+		status, _ = adminRequest(t, app, http.MethodGet, "/migrators/my_migrator", "")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("should return not found on unknown migrator", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		status, _ := adminRequest(t, app, http.MethodGet, "/migrators/my_migrator", "")
+		assert.Equal(t, http.StatusNotFound, status)
+	})
+
+	t.Run("should return the migration listing error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		migratorMock := mocks.NewMockMigrator(ctrl)
+		migratorMock.EXPECT().List().Return(nil, errors.New("list error"))
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			require.NoError(t, factory.Store("my_migrator", migratorMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		status, body := adminRequest(t, app, http.MethodGet, "/migrators", "")
+		assert.Equal(t, http.StatusInternalServerError, status)
+		assert.Equal(t, map[string]any{"error": "list error"}, body)
+	})
+
+	t.Run("should list the migrators migrations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		installedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		migratorMock := mocks.NewMockMigrator(ctrl)
+		migratorMock.EXPECT().List().Return([]flam.MigrationInfo{
+			{Version: "1.0.0", Description: "first", InstalledAt: &installedAt},
+			{Version: "2.0.0", Description: "second"}}, nil).Times(2)
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			require.NoError(t, factory.Store("my_migrator", migratorMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		expected := map[string]any{
+			"id": "my_migrator",
+			"migrations": []any{
+				map[string]any{"version": "1.0.0", "description": "first", "installed_at": "2024-01-01T00:00:00Z"},
+				map[string]any{"version": "2.0.0", "description": "second", "installed_at": nil}}}
+
+		status, body := adminRequest(t, app, http.MethodGet, "/migrators", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, []any{expected}, body)
+
+		status, body = adminRequest(t, app, http.MethodGet, "/migrators/my_migrator", "")
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, expected, body)
+	})
+}
+
+func Test_AdminProcess_Kennel(t *testing.T) {
+	t.Run("should be run and terminated by the kennel", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathAdminBoot, true)
+		_ = config.Set(flam.PathAdminAddress, "127.0.0.1:0")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, app.RunContext(ctx))
+	})
+}
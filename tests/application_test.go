@@ -1,17 +1,42 @@
 package tests
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
 
 	"github.com/cjdias/flam-in-go"
 	"github.com/cjdias/flam-in-go/tests/mocks"
 )
 
+type dependentBootableProvider struct {
+	*mocks.MockBootableProvider
+
+	dependencies []string
+}
+
+func (provider dependentBootableProvider) Dependencies() []string {
+	return provider.dependencies
+}
+
+type dependentClosableProvider struct {
+	*mocks.MockClosableProvider
+
+	dependencies []string
+}
+
+func (provider dependentClosableProvider) Dependencies() []string {
+	return provider.dependencies
+}
+
 func Test_Application_NewApplication(t *testing.T) {
 	assert.NotNil(t, flam.NewApplication())
 }
@@ -20,6 +45,42 @@ func Test_Application_Container(t *testing.T) {
 	assert.NotNil(t, flam.NewApplication().Container())
 }
 
+func Test_Application_State(t *testing.T) {
+	t.Run("should be registered on creation", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		assert.Equal(t, flam.ApplicationStateRegistered, app.State())
+	})
+
+	t.Run("should be booted after boot", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.Equal(t, flam.ApplicationStateBooted, app.State())
+	})
+
+	t.Run("should be running after run", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Run())
+
+		assert.Equal(t, flam.ApplicationStateRunning, app.State())
+	})
+
+	t.Run("should be closed after close", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Close())
+
+		assert.Equal(t, flam.ApplicationStateClosed, app.State())
+	})
+}
+
 func Test_Application_HasProvider(t *testing.T) {
 	t.Run("should return false in an unknown provider", func(t *testing.T) {
 		app := flam.NewApplication()
@@ -93,6 +154,20 @@ func Test_Application_Register(t *testing.T) {
 
 		assert.NoError(t, flam.NewApplication().Register(providerMock))
 	})
+
+	t.Run("should return invalid state error when registering after boot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		providerMock := mocks.NewMockProvider(ctrl)
+
+		assert.ErrorIs(t, app.Register(providerMock), flam.ErrInvalidApplicationState)
+	})
 }
 
 func Test_Application_Boot(t *testing.T) {
@@ -155,6 +230,38 @@ func Test_Application_Boot(t *testing.T) {
 		assert.NoError(t, app.Boot())
 	})
 
+	t.Run("should return all the config schema violations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathLogFlusherFrequency, "1m")
+		_ = config.Set("provider.port", 0)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockConfigSchemaProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Config(gomock.Any()).Return(nil)
+		providerMock.EXPECT().ConfigSchema().Return(flam.ConfigSchema{
+			{Path: "provider.host", Type: flam.ConfigTypeString, Required: true},
+			{Path: "provider.port", Type: flam.ConfigTypeInt, Min: 1}})
+
+		require.NoError(t, app.Register(providerMock))
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrInvalidConfig)
+
+		var validationErr *flam.ConfigValidationError
+		require.ErrorAs(t, e, &validationErr)
+		assert.Equal(t, []flam.ConfigViolation{
+			{Path: flam.PathLogFlusherFrequency, Message: "expected duration, got string"},
+			{Path: "provider.host", Message: "required value not found"},
+			{Path: "provider.port", Message: "value 0 lower than 1"}}, validationErr.Violations)
+	})
+
 	t.Run("should return an error if a source has already been registered with the same app source id", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -214,6 +321,81 @@ func Test_Application_Boot(t *testing.T) {
 		assert.NoError(t, app.Boot())
 	})
 
+	t.Run("should return an error if a provider depends on an unknown provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockDependentProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Dependencies().Return([]string{"unknown"}).AnyTimes()
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnknownProviderDependency)
+	})
+
+	t.Run("should return an error if providers have cyclic dependencies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		provider1Mock := mocks.NewMockDependentProvider(ctrl)
+		provider1Mock.EXPECT().Id().Return("provider1").AnyTimes()
+		provider1Mock.EXPECT().Register(gomock.Any()).Return(nil)
+		provider1Mock.EXPECT().Dependencies().Return([]string{"provider2"}).AnyTimes()
+
+		provider2Mock := mocks.NewMockDependentProvider(ctrl)
+		provider2Mock.EXPECT().Id().Return("provider2").AnyTimes()
+		provider2Mock.EXPECT().Register(gomock.Any()).Return(nil)
+		provider2Mock.EXPECT().Dependencies().Return([]string{"provider1"}).AnyTimes()
+
+		require.NoError(t, app.Register(provider1Mock))
+		require.NoError(t, app.Register(provider2Mock))
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrCyclicProviderDependency)
+	})
+
+	t.Run("should boot providers in dependency order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		provider1Mock := mocks.NewMockBootableProvider(ctrl)
+		provider1Mock.EXPECT().Id().Return("provider1").AnyTimes()
+		provider1Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		provider2Mock := mocks.NewMockBootableProvider(ctrl)
+		provider2Mock.EXPECT().Id().Return("provider2").AnyTimes()
+		provider2Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		provider3Mock := mocks.NewMockBootableProvider(ctrl)
+		provider3Mock.EXPECT().Id().Return("provider3").AnyTimes()
+		provider3Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		gomock.InOrder(
+			provider3Mock.EXPECT().Boot(gomock.Any()).Return(nil),
+			provider2Mock.EXPECT().Boot(gomock.Any()).Return(nil),
+			provider1Mock.EXPECT().Boot(gomock.Any()).Return(nil))
+
+		require.NoError(t, app.Register(dependentBootableProvider{
+			MockBootableProvider: provider1Mock,
+			dependencies:         []string{"provider2"}}))
+		require.NoError(t, app.Register(dependentBootableProvider{
+			MockBootableProvider: provider2Mock,
+			dependencies:         []string{"provider3"}}))
+		require.NoError(t, app.Register(provider3Mock))
+
+		assert.NoError(t, app.Boot())
+	})
+
 	t.Run("should add app config to config facade on boot", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -296,6 +478,84 @@ func Test_Application_Boot(t *testing.T) {
 			assert.Equal(t, "value1", config.Get("key"))
 		}))
 	})
+
+	t.Run("should return the context error if the context is done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		assert.ErrorIs(t, app.BootContext(ctx), context.Canceled)
+	})
+
+	t.Run("should stop waiting on a hanging config source boot when the context is done", func(t *testing.T) {
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			<-release
+		}))
+		defer server.Close()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverRest,
+				"uri":       server.URL,
+				"parser_id": "my_parser",
+				"path": flam.Bag{
+					"config": "config"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, app.BootContext(ctx), context.DeadlineExceeded)
+	})
+
+	t.Run("should boot context aware providers with the given context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		providerMock := mocks.NewMockContextBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().BootContext(ctx, gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.NoError(t, app.BootContext(ctx))
+	})
+
+	t.Run("should return invalid state error when booting a closed application", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Close())
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidApplicationState)
+	})
 }
 
 func Test_Application_Run(t *testing.T) {
@@ -374,6 +634,186 @@ func Test_Application_Run(t *testing.T) {
 
 		assert.NoError(t, app.Run())
 	})
+
+	t.Run("should run context aware providers with the given context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		providerMock := mocks.NewMockContextRunnableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().RunContext(ctx, gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.NoError(t, app.RunContext(ctx))
+	})
+
+	t.Run("should return invalid state error when already running", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Run())
+
+		assert.ErrorIs(t, app.Run(), flam.ErrInvalidApplicationState)
+	})
+
+	t.Run("should return invalid state error when running a closed application", func(t *testing.T) {
+		app := flam.NewApplication()
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Close())
+
+		assert.ErrorIs(t, app.Run(), flam.ErrInvalidApplicationState)
+	})
+}
+
+func Test_Application_Serve(t *testing.T) {
+	t.Run("should return boot error if any", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("boot error")
+		providerMock := mocks.NewMockBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Boot(gomock.Any()).Return(expectedErr)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.ErrorIs(t, app.Serve(context.Background()), expectedErr)
+	})
+
+	t.Run("should run and close the providers when the context is cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		runnableMock := mocks.NewMockRunnableProvider(ctrl)
+		runnableMock.EXPECT().Id().Return("runnable").AnyTimes()
+		runnableMock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		closableMock := mocks.NewMockClosableProvider(ctrl)
+		closableMock.EXPECT().Id().Return("closable").AnyTimes()
+		closableMock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(runnableMock))
+		require.NoError(t, app.Register(closableMock))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		gomock.InOrder(
+			runnableMock.EXPECT().Run(gomock.Any()).DoAndReturn(func(*dig.Container) error {
+				cancel()
+				return nil
+			}),
+			closableMock.EXPECT().Close(gomock.Any()).Return(nil))
+
+		assert.NoError(t, app.Serve(ctx))
+	})
+
+	t.Run("should close the providers and return the run error if any", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		expectedErr := errors.New("run error")
+		runnableMock := mocks.NewMockRunnableProvider(ctrl)
+		runnableMock.EXPECT().Id().Return("runnable").AnyTimes()
+		runnableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		runnableMock.EXPECT().Run(gomock.Any()).Return(expectedErr)
+
+		closableMock := mocks.NewMockClosableProvider(ctrl)
+		closableMock.EXPECT().Id().Return("closable").AnyTimes()
+		closableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		closableMock.EXPECT().Close(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(runnableMock))
+		require.NoError(t, app.Register(closableMock))
+
+		assert.ErrorIs(t, app.Serve(context.Background()), expectedErr)
+	})
+
+	t.Run("should return the close error of the failing provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		expectedErr := errors.New("close error")
+		closableMock := mocks.NewMockClosableProvider(ctrl)
+		closableMock.EXPECT().Id().Return("closable").AnyTimes()
+		closableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		closableMock.EXPECT().Close(gomock.Any()).Return(expectedErr)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		runnableMock := mocks.NewMockRunnableProvider(ctrl)
+		runnableMock.EXPECT().Id().Return("runnable").AnyTimes()
+		runnableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		runnableMock.EXPECT().Run(gomock.Any()).DoAndReturn(func(*dig.Container) error {
+			cancel()
+			return nil
+		})
+
+		require.NoError(t, app.Register(closableMock))
+		require.NoError(t, app.Register(runnableMock))
+
+		e := app.Serve(ctx)
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, flam.ErrServeFailed)
+		assert.ErrorIs(t, e, flam.ErrCloseFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		closeErrs := multiErr.Origin("close")
+		require.Len(t, closeErrs, 1)
+		require.ErrorAs(t, closeErrs[0], &multiErr)
+		assert.Equal(t, []error{expectedErr}, multiErr.Origin("closable"))
+	})
+
+	t.Run("should report the providers that did not close before the shutdown timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathShutdownTimeout, 10*time.Millisecond)
+
+		app := flam.NewApplication(config)
+
+		closableMock := mocks.NewMockClosableProvider(ctrl)
+		closableMock.EXPECT().Id().Return("closable").AnyTimes()
+		closableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		closableMock.EXPECT().Close(gomock.Any()).DoAndReturn(func(*dig.Container) error {
+			time.Sleep(100 * time.Millisecond)
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		runnableMock := mocks.NewMockRunnableProvider(ctrl)
+		runnableMock.EXPECT().Id().Return("runnable").AnyTimes()
+		runnableMock.EXPECT().Register(gomock.Any()).Return(nil)
+		runnableMock.EXPECT().Run(gomock.Any()).DoAndReturn(func(*dig.Container) error {
+			cancel()
+			return nil
+		})
+
+		require.NoError(t, app.Register(closableMock))
+		require.NoError(t, app.Register(runnableMock))
+
+		e := app.Serve(ctx)
+		assert.ErrorIs(t, e, flam.ErrProviderCloseTimeout)
+		assert.ErrorContains(t, e, "closable")
+	})
 }
 
 func Test_Application_Close(t *testing.T) {
@@ -415,4 +855,302 @@ func Test_Application_Close(t *testing.T) {
 
 		assert.NoError(t, app.Close())
 	})
+
+	t.Run("should close providers in reverse dependency order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		provider1Mock := mocks.NewMockClosableProvider(ctrl)
+		provider1Mock.EXPECT().Id().Return("provider1").AnyTimes()
+		provider1Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		provider2Mock := mocks.NewMockClosableProvider(ctrl)
+		provider2Mock.EXPECT().Id().Return("provider2").AnyTimes()
+		provider2Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		provider3Mock := mocks.NewMockClosableProvider(ctrl)
+		provider3Mock.EXPECT().Id().Return("provider3").AnyTimes()
+		provider3Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		gomock.InOrder(
+			provider3Mock.EXPECT().Close(gomock.Any()).Return(nil),
+			provider2Mock.EXPECT().Close(gomock.Any()).Return(nil),
+			provider1Mock.EXPECT().Close(gomock.Any()).Return(nil))
+
+		require.NoError(t, app.Register(provider1Mock))
+		require.NoError(t, app.Register(dependentClosableProvider{
+			MockClosableProvider: provider3Mock,
+			dependencies:         []string{"provider2"}}))
+		require.NoError(t, app.Register(provider2Mock))
+
+		assert.NoError(t, app.Close())
+	})
+
+	t.Run("should close providers in reverse registration order if dependencies are unresolvable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		provider1Mock := mocks.NewMockClosableProvider(ctrl)
+		provider1Mock.EXPECT().Id().Return("provider1").AnyTimes()
+		provider1Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		provider2Mock := mocks.NewMockClosableProvider(ctrl)
+		provider2Mock.EXPECT().Id().Return("provider2").AnyTimes()
+		provider2Mock.EXPECT().Register(gomock.Any()).Return(nil)
+
+		gomock.InOrder(
+			provider2Mock.EXPECT().Close(gomock.Any()).Return(nil),
+			provider1Mock.EXPECT().Close(gomock.Any()).Return(nil))
+
+		require.NoError(t, app.Register(provider1Mock))
+		require.NoError(t, app.Register(dependentClosableProvider{
+			MockClosableProvider: provider2Mock,
+			dependencies:         []string{"unknown"}}))
+
+		assert.NoError(t, app.Close())
+	})
+
+	t.Run("should close context aware providers with the given context", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		providerMock := mocks.NewMockContextClosableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().CloseContext(ctx, gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.NoError(t, app.CloseContext(ctx))
+	})
+
+	t.Run("should close the providers only once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		providerMock := mocks.NewMockClosableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Close(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.NoError(t, app.Close())
+		assert.NoError(t, app.Close())
+	})
+}
+
+func Test_Application_Restart(t *testing.T) {
+	t.Run("should return the close error if any", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		expectedErr := errors.New("close error")
+		providerMock := mocks.NewMockClosableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Close(gomock.Any()).Return(expectedErr)
+
+		require.NoError(t, app.Register(providerMock))
+		require.NoError(t, app.Boot())
+
+		assert.ErrorIs(t, app.Restart(), expectedErr)
+	})
+
+	t.Run("should allow a boot after a failed close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("close error")
+		providerMock := mocks.NewMockClosableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Close(gomock.Any()).Return(expectedErr)
+		providerMock.EXPECT().Close(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+		require.NoError(t, app.Boot())
+		require.ErrorIs(t, app.Restart(), expectedErr)
+
+		assert.Equal(t, flam.ApplicationStateRegistered, app.State())
+		assert.NoError(t, app.Boot())
+		assert.Equal(t, flam.ApplicationStateBooted, app.State())
+	})
+
+	t.Run("should not close the providers of a not booted application", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+
+		providerMock := mocks.NewMockClosableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Close(gomock.Any()).Return(nil)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.NoError(t, app.Restart())
+		assert.Equal(t, flam.ApplicationStateBooted, app.State())
+		assert.NoError(t, app.Close())
+	})
+
+	t.Run("should close and reboot the providers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Boot(gomock.Any()).Return(nil).Times(2)
+
+		require.NoError(t, app.Register(providerMock))
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Restart())
+		assert.Equal(t, flam.ApplicationStateBooted, app.State())
+	})
+
+	t.Run("should reboot a closed application", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathLogBoot, true)
+		_ = config.Set(flam.PathRedisMiniBoot, true)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Close())
+
+		assert.NoError(t, app.Restart())
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, true, config.Get(flam.PathConfigBoot))
+		}))
+	})
+}
+
+func Test_Application_Execute(t *testing.T) {
+	t.Run("should return the boot error if any", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("boot error")
+		providerMock := mocks.NewMockBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).Return(nil)
+		providerMock.EXPECT().Boot(gomock.Any()).Return(expectedErr)
+
+		require.NoError(t, app.Register(providerMock))
+
+		assert.ErrorIs(t, app.Execute([]string{flam.CommandHelp}), expectedErr)
+	})
+
+	t.Run("should boot the application and execute the command", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("command error")
+		commandMock := mocks.NewMockCommand(ctrl)
+		commandMock.EXPECT().Name().Return("my_command")
+		commandMock.EXPECT().Run([]string{"arg"}, gomock.Any()).Return(expectedErr)
+		require.NoError(t, app.Container().Provide(func() flam.Command {
+			return commandMock
+		}, dig.Group(flam.CommandGroup)))
+
+		assert.ErrorIs(t, app.Execute([]string{"my_command", "arg"}), expectedErr)
+		assert.Equal(t, flam.ApplicationStateBooted, app.State())
+	})
+}
+
+func Test_Application_Events(t *testing.T) {
+	subscribe := func(t *testing.T, app flam.Application) *[]string {
+		var events []string
+		require.NoError(t, app.Container().Invoke(func(pubSub flam.PubSub[string, string]) {
+			for _, channel := range []string{flam.EventApplicationBooted, flam.EventApplicationRunning, flam.EventApplicationClosing} {
+				require.NoError(t, pubSub.Subscribe("test", channel, func(channel string, _ ...any) error {
+					events = append(events, channel)
+					return nil
+				}))
+			}
+		}))
+
+		return &events
+	}
+
+	t.Run("should publish the lifecycle events", func(t *testing.T) {
+		app := flam.NewApplication()
+		events := subscribe(t, app)
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Run())
+		require.NoError(t, app.Close())
+		require.NoError(t, app.Close())
+
+		assert.Equal(t, []string{
+			flam.EventApplicationBooted,
+			flam.EventApplicationRunning,
+			flam.EventApplicationClosing}, *events)
+	})
+
+	t.Run("should publish the booted event when run boots the application", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+		events := subscribe(t, app)
+
+		require.NoError(t, app.Run())
+
+		assert.Equal(t, []string{
+			flam.EventApplicationBooted,
+			flam.EventApplicationRunning}, *events)
+	})
+
+	t.Run("should not publish the closing event when restarting a not booted application", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+		events := subscribe(t, app)
+
+		require.NoError(t, app.Restart())
+
+		assert.Equal(t, []string{flam.EventApplicationBooted}, *events)
+	})
+
+	t.Run("should publish the closing and booted events on restart", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+		events := subscribe(t, app)
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Restart())
+
+		assert.Equal(t, []string{
+			flam.EventApplicationBooted,
+			flam.EventApplicationClosing,
+			flam.EventApplicationBooted}, *events)
+	})
 }
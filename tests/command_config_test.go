@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_ConfigSourcesCommand(t *testing.T) {
+	t.Run("should return invalid arguments error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e := commander.Execute([]string{flam.CommandConfigSources, "arg"}, &bytes.Buffer{})
+			assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+		}))
+	})
+
+	t.Run("should list the stored config sources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		sourceMock := mocks.NewMockConfigSource(ctrl)
+		sourceMock.EXPECT().GetPriority().Return(10).AnyTimes()
+		sourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{}).AnyTimes()
+		sourceMock.EXPECT().Close().Return(nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			require.NoError(t, factory.Store("my_source", sourceMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute([]string{flam.CommandConfigSources}, output))
+			assert.Equal(t, "ID         PRIORITY\n__app      0\nmy_source  10\n", output.String())
+		}))
+	})
+}
+
+func Test_ConfigDumpCommand(t *testing.T) {
+	t.Run("should return invalid arguments error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e := commander.Execute([]string{flam.CommandConfigDump, "one", "two"}, &bytes.Buffer{})
+			assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+		}))
+	})
+
+	t.Run("should dump the redacted aggregated config", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set("app.name", "my_app")
+		_ = config.Set("app.database.password", "my_password")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute([]string{flam.CommandConfigDump}, output))
+
+			var data map[string]any
+			require.NoError(t, json.Unmarshal(output.Bytes(), &data))
+			assert.Equal(t, map[string]any{
+				"name": "my_app",
+				"database": map[string]any{
+					"password": flam.ConfigRedactedValue}}, data["app"])
+		}))
+	})
+
+	t.Run("should dump a config sub path", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set("app.name", "my_app")
+		_ = config.Set("app.database.password", "my_password")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute([]string{flam.CommandConfigDump, "app.database"}, output))
+			assert.Equal(t, "{\n  \"password\": \"********\"\n}\n", output.String())
+		}))
+	})
+}
+
+func Test_ConfigEncryptCommand(t *testing.T) {
+	t.Run("should return invalid arguments error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e := commander.Execute([]string{flam.CommandConfigEncrypt, "v1", "value"}, &bytes.Buffer{})
+			assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+		}))
+	})
+
+	t.Run("should return unknown resource error on unknown key", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e := commander.Execute([]string{flam.CommandConfigEncrypt, "v1"}, &bytes.Buffer{})
+			assert.ErrorIs(t, e, flam.ErrUnknownResource)
+		}))
+	})
+
+	t.Run("should print the encrypted input value", func(t *testing.T) {
+		key, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigEncryptionKeys, flam.Bag{"v1": key})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Container().Provide(func() io.Reader {
+			return strings.NewReader("value\n")
+		}, dig.Name(flam.CommandInput)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			require.NoError(t, commander.Execute([]string{flam.CommandConfigEncrypt, "v1"}, output))
+
+			value, e := flam.DecryptConfigValue(strings.TrimSpace(output.String()), map[string]string{"v1": key})
+			assert.NoError(t, e)
+			assert.Equal(t, "value", value)
+		}))
+	})
+}
@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_KennelProcessesCommand(t *testing.T) {
+	t.Run("should return invalid arguments error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e := commander.Execute([]string{flam.CommandKennelProcesses, "arg"}, &bytes.Buffer{})
+			assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+		}))
+	})
+
+	t.Run("should list the kennel processes", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_b": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		processAMock := mocks.NewMockProcess(ctrl)
+		processAMock.EXPECT().Id().Return("process_a")
+		processAMock.EXPECT().IsRunning().Return(true)
+		processBMock := mocks.NewMockProcess(ctrl)
+		processBMock.EXPECT().Id().Return("process_b")
+		processBMock.EXPECT().IsRunning().Return(false)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processAMock
+		}, dig.Group(flam.ProcessGroup)))
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processBMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute([]string{flam.CommandKennelProcesses}, output))
+			assert.Equal(t, "ID         ACTIVE  RUNNING\n"+
+				"process_a  false   true\n"+
+				"process_b  true    false\n", output.String())
+		}))
+	})
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_MigratorCommand(t *testing.T) {
+	execute := func(t *testing.T, app flam.Application, args ...string) (string, error) {
+		output := &bytes.Buffer{}
+		var e error
+		require.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			e = commander.Execute(args, output)
+		}))
+
+		return output.String(), e
+	}
+
+	t.Run("should return invalid arguments error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		_, e := execute(t, app, flam.CommandMigratorList)
+		assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+
+		_, e = execute(t, app, flam.CommandMigratorUp, "one", "two")
+		assert.ErrorIs(t, e, flam.ErrInvalidCommandArguments)
+	})
+
+	t.Run("should return migrator retrieval error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		_, e := execute(t, app, flam.CommandMigratorList, "my_migrator")
+		assert.ErrorIs(t, e, flam.ErrUnknownResource)
+	})
+
+	t.Run("should list the migrator migrations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		installedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		migratorMock := mocks.NewMockMigrator(ctrl)
+		migratorMock.EXPECT().List().Return([]flam.MigrationInfo{
+			{Version: "1.0.0", Description: "first", InstalledAt: &installedAt},
+			{Version: "2.0.0", Description: "second"}}, nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			require.NoError(t, factory.Store("my_migrator", migratorMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		output, e := execute(t, app, flam.CommandMigratorList, "my_migrator")
+		assert.NoError(t, e)
+		assert.Equal(t, "VERSION  DESCRIPTION  INSTALLED AT\n"+
+			"1.0.0    first        2024-01-01T00:00:00Z\n"+
+			"2.0.0    second       -\n", output)
+	})
+
+	t.Run("should return the migration action error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("up error")
+		migratorMock := mocks.NewMockMigrator(ctrl)
+		migratorMock.EXPECT().Up().Return(expectedErr)
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			require.NoError(t, factory.Store("my_migrator", migratorMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		_, e := execute(t, app, flam.CommandMigratorUp, "my_migrator")
+		assert.ErrorIs(t, e, expectedErr)
+	})
+
+	t.Run("should execute the migration actions and print the current migration", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		migratorMock := mocks.NewMockMigrator(ctrl)
+		gomock.InOrder(
+			migratorMock.EXPECT().Up().Return(nil),
+			migratorMock.EXPECT().Current().Return(&flam.MigrationInfo{Version: "1.0.0"}, nil),
+			migratorMock.EXPECT().UpAll().Return(nil),
+			migratorMock.EXPECT().Current().Return(&flam.MigrationInfo{Version: "2.0.0"}, nil),
+			migratorMock.EXPECT().Down().Return(nil),
+			migratorMock.EXPECT().Current().Return(&flam.MigrationInfo{Version: "1.0.0"}, nil),
+			migratorMock.EXPECT().DownAll().Return(nil),
+			migratorMock.EXPECT().Current().Return(nil, nil))
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			require.NoError(t, factory.Store("my_migrator", migratorMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		output, e := execute(t, app, flam.CommandMigratorUp, "my_migrator")
+		assert.NoError(t, e)
+		assert.Equal(t, "current migration: 1.0.0\n", output)
+
+		output, e = execute(t, app, flam.CommandMigratorUpAll, "my_migrator")
+		assert.NoError(t, e)
+		assert.Equal(t, "current migration: 2.0.0\n", output)
+
+		output, e = execute(t, app, flam.CommandMigratorDown, "my_migrator")
+		assert.NoError(t, e)
+		assert.Equal(t, "current migration: 1.0.0\n", output)
+
+		output, e = execute(t, app, flam.CommandMigratorDownAll, "my_migrator")
+		assert.NoError(t, e)
+		assert.Equal(t, "current migration: -\n", output)
+	})
+}
@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_Commander_NewCommander(t *testing.T) {
+	t.Run("should return duplicate command error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		commandMock := mocks.NewMockCommand(ctrl)
+		commandMock.EXPECT().Name().Return(flam.CommandConfigDump)
+		require.NoError(t, app.Container().Provide(func() flam.Command {
+			return commandMock
+		}, dig.Group(flam.CommandGroup)))
+
+		require.NoError(t, app.Boot())
+
+		e := app.Container().Invoke(func(flam.Commander) {})
+		assert.ErrorIs(t, e, flam.ErrDuplicateCommand)
+	})
+
+	t.Run("should register the built-in commands", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			assert.Equal(t, []string{
+				flam.CommandConfigDump,
+				flam.CommandConfigEncrypt,
+				flam.CommandConfigSources,
+				flam.CommandKennelProcesses,
+				flam.CommandMigratorDown,
+				flam.CommandMigratorDownAll,
+				flam.CommandMigratorList,
+				flam.CommandMigratorUp,
+				flam.CommandMigratorUpAll}, commander.Commands())
+		}))
+	})
+}
+
+func Test_Commander_Execute(t *testing.T) {
+	t.Run("should print the usage if no command is given", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute(nil, output))
+			assert.Contains(t, output.String(), "usage: <command> [arguments]")
+			assert.Contains(t, output.String(), flam.CommandHelp)
+			assert.Contains(t, output.String(), flam.CommandMigratorUpAll)
+		}))
+	})
+
+	t.Run("should print a command usage on help", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.NoError(t, commander.Execute([]string{flam.CommandHelp, flam.CommandMigratorUp}, output))
+			assert.Equal(t, "usage: migrator:up <migrator_id>\n\nexecute the next pending migration of a migrator\n", output.String())
+		}))
+	})
+
+	t.Run("should return unknown command error on help of an unknown command", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.ErrorIs(t, commander.Execute([]string{flam.CommandHelp, "unknown"}, output), flam.ErrUnknownCommand)
+			assert.Contains(t, output.String(), "usage: <command> [arguments]")
+		}))
+	})
+
+	t.Run("should return unknown command error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			output := &bytes.Buffer{}
+			assert.ErrorIs(t, commander.Execute([]string{"unknown"}, output), flam.ErrUnknownCommand)
+			assert.Contains(t, output.String(), "usage: <command> [arguments]")
+		}))
+	})
+
+	t.Run("should dispatch the arguments to the command", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		output := &bytes.Buffer{}
+		expectedErr := errors.New("command error")
+		commandMock := mocks.NewMockCommand(ctrl)
+		commandMock.EXPECT().Name().Return("my_command")
+		commandMock.EXPECT().Run([]string{"arg1", "arg2"}, output).Return(expectedErr)
+		require.NoError(t, app.Container().Provide(func() flam.Command {
+			return commandMock
+		}, dig.Group(flam.CommandGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			assert.ErrorIs(t, commander.Execute([]string{"my_command", "arg1", "arg2"}, output), expectedErr)
+		}))
+	})
+}
@@ -0,0 +1,229 @@
+package tests
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_EncryptConfigValue(t *testing.T) {
+	t.Run("should return invalid key error on a non base64 key", func(t *testing.T) {
+		_, e := flam.EncryptConfigValue("v1", "invalid key", "value")
+		assert.ErrorIs(t, e, flam.ErrInvalidConfigEncryptionKey)
+	})
+
+	t.Run("should return invalid key error on an invalid key length", func(t *testing.T) {
+		_, e := flam.EncryptConfigValue("v1", "a2V5", "value")
+		assert.ErrorIs(t, e, flam.ErrInvalidConfigEncryptionKey)
+	})
+
+	t.Run("should encrypt the value with the key id", func(t *testing.T) {
+		key, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		assert.True(t, strings.HasPrefix(encrypted, "enc:v1:"))
+		assert.NotContains(t, encrypted, "value")
+	})
+}
+
+func Test_DecryptConfigValue(t *testing.T) {
+	key, e := flam.GenerateConfigEncryptionKey()
+	require.NoError(t, e)
+
+	t.Run("should return non encrypted values as is", func(t *testing.T) {
+		value, e := flam.DecryptConfigValue("value", nil)
+		assert.NoError(t, e)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("should return invalid value error on missing key id", func(t *testing.T) {
+		_, e := flam.DecryptConfigValue("enc:payload", map[string]string{"v1": key})
+		assert.ErrorIs(t, e, flam.ErrInvalidEncryptedConfigValue)
+	})
+
+	t.Run("should return unknown resource error on unknown key id", func(t *testing.T) {
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		_, e = flam.DecryptConfigValue(encrypted, map[string]string{"v2": key})
+		assert.ErrorIs(t, e, flam.ErrUnknownResource)
+	})
+
+	t.Run("should return invalid value error on tampered values", func(t *testing.T) {
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		_, e = flam.DecryptConfigValue(encrypted[:len(encrypted)-4]+"AAAA", map[string]string{"v1": key})
+		assert.ErrorIs(t, e, flam.ErrInvalidEncryptedConfigValue)
+	})
+
+	t.Run("should return invalid value error when decrypting with another key", func(t *testing.T) {
+		other, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		_, e = flam.DecryptConfigValue(encrypted, map[string]string{"v1": other})
+		assert.ErrorIs(t, e, flam.ErrInvalidEncryptedConfigValue)
+	})
+
+	t.Run("should decrypt the value", func(t *testing.T) {
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		value, e := flam.DecryptConfigValue(encrypted, map[string]string{"v1": key})
+		assert.NoError(t, e)
+		assert.Equal(t, "value", value)
+	})
+}
+
+func Test_ConfigEncryption(t *testing.T) {
+	t.Run("should decrypt the loaded values with the rotated keys", func(t *testing.T) {
+		oldKey, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+		newKey, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		t.Setenv("FLAMTEST_KEY_V1", oldKey)
+		t.Setenv("FLAMTEST_KEY_V2", newKey)
+
+		oldValue, e := flam.EncryptConfigValue("v1", oldKey, "old-encrypted-value")
+		require.NoError(t, e)
+		newValue, e := flam.EncryptConfigValue("v2", newKey, "new-encrypted-value")
+		require.NoError(t, e)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"config": flam.Bag{
+					"encryption": flam.Bag{
+						"keys": flam.Bag{
+							"v1": "secret://env/FLAMTEST_KEY_V1",
+							"v2": "secret://env/FLAMTEST_KEY_V2"},
+						"paths": []any{"old", "list"}}}},
+			"old":  oldValue,
+			"list": []any{newValue}})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, "old-encrypted-value", config.Get("old"))
+			assert.Equal(t, []any{"new-encrypted-value"}, config.Get("list"))
+		}))
+	})
+
+	t.Run("should return invalid value error on encrypted values out of the encryption paths", func(t *testing.T) {
+		key, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		encrypted, e := flam.EncryptConfigValue("v1", key, "encrypted-value")
+		require.NoError(t, e)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"config": flam.Bag{
+					"encryption": flam.Bag{
+						"keys": flam.Bag{
+							"v1": key},
+						"paths": []any{"app.secrets"}}}},
+			"app": flam.Bag{
+				"secrets": flam.Bag{
+					"value": encrypted},
+				"label": encrypted}})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			e := factory.Store("my_source", configSourceMock)
+			assert.ErrorIs(t, e, flam.ErrInvalidEncryptedConfigValue)
+			assert.ErrorContains(t, e, "app.label")
+			assert.Nil(t, config.Get("app"))
+		}))
+	})
+
+	t.Run("should decrypt every encrypted value without encryption paths and redact them from the dumps", func(t *testing.T) {
+		key, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		encrypted, e := flam.EncryptConfigValue("v1", key, "encrypted-value")
+		require.NoError(t, e)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"config": flam.Bag{
+					"encryption": flam.Bag{
+						"keys": flam.Bag{
+							"v1": key}}}},
+			"value": encrypted})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config, commander flam.Commander) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+			assert.Equal(t, "encrypted-value", config.Get("value"))
+
+			var output bytes.Buffer
+			require.NoError(t, commander.Execute([]string{flam.CommandConfigDump}, &output))
+			assert.NotContains(t, output.String(), "encrypted-value")
+			assert.NotContains(t, output.String(), key)
+		}))
+	})
+
+	t.Run("should return the decryption error", func(t *testing.T) {
+		key, e := flam.GenerateConfigEncryptionKey()
+		require.NoError(t, e)
+
+		encrypted, e := flam.EncryptConfigValue("v1", key, "value")
+		require.NoError(t, e)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"config": flam.Bag{
+					"encryption": flam.Bag{
+						"paths": []any{"value"}}}},
+			"value": encrypted})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			assert.ErrorIs(t, factory.Store("my_source", configSourceMock), flam.ErrUnknownResource)
+			assert.Nil(t, config.Get("value"))
+		}))
+	})
+}
@@ -0,0 +1,296 @@
+package tests
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_ConfigRestClientGenerator_Create(t *testing.T) {
+	create := func(t *testing.T, app flam.Application, config flam.Bag) (flam.ConfigRestClient, error) {
+		var client flam.ConfigRestClient
+		var e error
+		require.NoError(t, app.Container().Invoke(func(generator flam.ConfigRestClientGenerator) {
+			client, e = generator.Create(config)
+		}))
+
+		return client, e
+	}
+
+	get := func(t *testing.T, client flam.ConfigRestClient, uri string) (*http.Response, error) {
+		request, e := http.NewRequest(http.MethodGet, uri, http.NoBody)
+		require.NoError(t, e)
+
+		return client.Do(request)
+	}
+
+	t.Run("should return invalid config if the retry attempts are not positive", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		client, e := create(t, app, flam.Bag{"retry": flam.Bag{"attempts": 0}})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, e, flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should send the configured headers and bearer token", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, "value", request.Header.Get("X-Header"))
+			assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{
+			"headers": flam.Bag{"X-Header": "value"},
+			"auth":    flam.Bag{"token": "token"}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("should send the configured basic auth credentials", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			username, password, ok := request.BasicAuth()
+			assert.True(t, ok)
+			assert.Equal(t, "user", username)
+			assert.Equal(t, "secret", password)
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{
+			"auth": flam.Bag{"username": "user", "password": "secret"}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+
+	t.Run("should return a timeout error on a slow server", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			time.Sleep(100 * time.Millisecond)
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{"timeout": 10 * time.Millisecond})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		assert.Nil(t, response)
+		assert.ErrorContains(t, e, "Client.Timeout")
+	})
+
+	t.Run("should retry the server errors until success", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		calls := atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if calls.Add(1) < 3 {
+				writer.WriteHeader(http.StatusServiceUnavailable)
+			}
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{
+			"retry": flam.Bag{"attempts": 3, "backoff": time.Millisecond}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should return the last response when the retries are exhausted", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		calls := atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			calls.Add(1)
+			writer.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{
+			"retry": flam.Bag{"attempts": 2, "backoff": time.Millisecond}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("should not retry client errors", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		calls := atomic.Int32{}
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			calls.Add(1)
+			writer.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client, e := create(t, app, flam.Bag{
+			"retry": flam.Bag{"attempts": 3, "backoff": time.Millisecond}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusNotFound, response.StatusCode)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("should return the tls disk retrieval error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		client, e := create(t, app, flam.Bag{
+			"tls": flam.Bag{"disk_id": "my_disk", "ca": "/ca.pem"}})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, e, flam.ErrUnknownResource)
+	})
+
+	t.Run("should return invalid config on an invalid ca file", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		disk := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(disk, "/ca.pem", []byte("invalid"), 0o644))
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", disk))
+		}))
+
+		client, e := create(t, app, flam.Bag{
+			"tls": flam.Bag{"disk_id": "my_disk", "ca": "/ca.pem"}})
+		assert.Nil(t, client)
+		assert.ErrorIs(t, e, flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should return the client certificate reading error", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", afero.NewMemMapFs()))
+		}))
+
+		client, e := create(t, app, flam.Bag{
+			"tls": flam.Bag{"disk_id": "my_disk", "cert": "/cert.pem", "key": "/key.pem"}})
+		assert.Nil(t, client)
+		assert.ErrorContains(t, e, "file does not exist")
+	})
+
+	t.Run("should trust the configured ca and present the client certificate", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		cert, key, certificate := generateClientCertificate(t)
+
+		clientCAs := x509.NewCertPool()
+		clientCAs.AddCert(certificate)
+
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Len(t, request.TLS.PeerCertificates, 1)
+		}))
+		server.TLS = &tls.Config{
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs}
+		server.StartTLS()
+		defer server.Close()
+
+		disk := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(disk, "/ca.pem", pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: server.Certificate().Raw}), 0o644))
+		require.NoError(t, afero.WriteFile(disk, "/cert.pem", cert, 0o644))
+		require.NoError(t, afero.WriteFile(disk, "/key.pem", key, 0o644))
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", disk))
+		}))
+
+		client, e := create(t, app, flam.Bag{
+			"tls": flam.Bag{
+				"disk_id": "my_disk",
+				"ca":      "/ca.pem",
+				"cert":    "/cert.pem",
+				"key":     "/key.pem"}})
+		require.NoError(t, e)
+
+		response, e := get(t, client, server.URL)
+		require.NoError(t, e)
+		defer func() { _ = response.Body.Close() }()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+	})
+}
+
+func generateClientCertificate(
+	t *testing.T,
+) ([]byte, []byte, *x509.Certificate) {
+	privateKey, e := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, e)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true}
+
+	der, e := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, e)
+
+	certificate, e := x509.ParseCertificate(der)
+	require.NoError(t, e)
+
+	keyDer, e := x509.MarshalECPrivateKey(privateKey)
+	require.NoError(t, e)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+		certificate
+}
@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_ConfigSchema_Validate(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		value    any
+		entry    flam.ConfigSchemaEntry
+		expected []flam.ConfigViolation
+	}{
+		{
+			name:  "should accept a missing optional value",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeInt},
+		},
+		{
+			name:  "should report a missing required value",
+			entry: flam.ConfigSchemaEntry{Path: "field", Required: true},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "required value not found"}},
+		},
+		{
+			name:  "should accept any value type",
+			value: []string{"value"},
+			entry: flam.ConfigSchemaEntry{Path: "field", Required: true},
+		},
+		{
+			name:  "should report an unexpected bool type",
+			value: "true",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeBool},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "expected bool, got string"}},
+		},
+		{
+			name:  "should report an unexpected int type",
+			value: 1.5,
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeInt},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "expected int, got float64"}},
+		},
+		{
+			name:  "should accept an int as float",
+			value: 1,
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeFloat},
+		},
+		{
+			name:  "should report an unexpected duration type",
+			value: "1m",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeDuration},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "expected duration, got string"}},
+		},
+		{
+			name:  "should accept a list",
+			value: []any{"value"},
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeList},
+		},
+		{
+			name:  "should report an unexpected bag type",
+			value: []any{"value"},
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeBag},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "expected bag, got []interface {}"}},
+		},
+		{
+			name:  "should report a not allowed value",
+			value: "trace",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeString, Allowed: []any{"info", "debug"}},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "value trace not in [info debug]"}},
+		},
+		{
+			name:  "should accept an allowed value",
+			value: "debug",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeString, Allowed: []any{"info", "debug"}},
+		},
+		{
+			name:  "should report a value lower than the minimum",
+			value: 0,
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeInt, Min: 1, Max: 10},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "value 0 lower than 1"}},
+		},
+		{
+			name:  "should report a value greater than the maximum",
+			value: 11,
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeInt, Min: 1, Max: 10},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "value 11 greater than 10"}},
+		},
+		{
+			name:  "should compare durations given in milliseconds",
+			value: 500,
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeDuration, Min: time.Second},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "value 500 lower than 1s"}},
+		},
+		{
+			name:  "should compare the string length",
+			value: "abc",
+			entry: flam.ConfigSchemaEntry{Path: "field", Type: flam.ConfigTypeString, Min: 1, Max: 2},
+			expected: []flam.ConfigViolation{{
+				Path:    "field",
+				Message: "value abc greater than 2"}},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			config := flam.Bag{}
+			if scenario.value != nil {
+				_ = config.Set("field", scenario.value)
+			}
+
+			app := flam.NewApplication(config)
+			defer func() { _ = app.Close() }()
+
+			require.NoError(t, app.Boot())
+
+			assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+				schema := flam.ConfigSchema{scenario.entry}
+				assert.Equal(t, scenario.expected, schema.Validate(config))
+			}))
+		})
+	}
+}
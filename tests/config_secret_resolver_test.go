@@ -0,0 +1,261 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/flamtest"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_ConfigSecretResolver(t *testing.T) {
+	t.Run("should resolve env secrets", func(t *testing.T) {
+		t.Setenv("FLAMTEST_SECRET", "env-secret-value")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"password": "secret://env/FLAMTEST_SECRET"})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, "env-secret-value", config.Get("password"))
+		}))
+	})
+
+	t.Run("should return not found error on missing env secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"password": "secret://env/FLAMTEST_MISSING_SECRET"})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			assert.ErrorIs(t, factory.Store("my_source", configSourceMock), flam.ErrSecretNotFound)
+		}))
+	})
+
+	t.Run("should return unknown resource error on unknown secret driver", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"password": "secret://vault/my_secret"})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			assert.ErrorIs(t, factory.Store("my_source", configSourceMock), flam.ErrUnknownResource)
+		}))
+	})
+
+	t.Run("should resolve file secrets from the configured disk", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		harness := flamtest.New(t).
+			Set(flam.PathConfigSecretDiskId, flamtest.DiskId).
+			MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"password": "secret://file/secrets/db"}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		harness.Invoke(func(diskFactory flam.DiskFactory, factory flam.ConfigSourceFactory, config flam.Config) {
+			disk, e := diskFactory.Get(flamtest.DiskId)
+			require.NoError(t, e)
+			require.NoError(t, afero.WriteFile(disk, "/secrets/db", []byte("file-secret-value\n"), 0o600))
+
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, "file-secret-value", config.Get("password"))
+		})
+	})
+
+	t.Run("should return not found error on missing file secrets", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		harness := flamtest.New(t).
+			Set(flam.PathConfigSecretDiskId, flamtest.DiskId).
+			MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"password": "secret://file/secrets/missing"}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		harness.Invoke(func(factory flam.ConfigSourceFactory) {
+			assert.ErrorIs(t, factory.Store("my_source", configSourceMock), flam.ErrSecretNotFound)
+		})
+	})
+
+	t.Run("should redact the resolved secret paths from the dumps", func(t *testing.T) {
+		t.Setenv("FLAMTEST_REDACTED_SECRET", "redacted-secret-value")
+
+		ctrl := gomock.NewController(t)
+
+		harness := flamtest.New(t).MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"app": flam.Bag{
+				"auth":  "secret://env/FLAMTEST_REDACTED_SECRET",
+				"other": "redacted-secret-value"}}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		harness.Invoke(func(
+			factory flam.ConfigSourceFactory,
+			commander flam.Commander,
+		) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			var output bytes.Buffer
+			require.NoError(t, commander.Execute([]string{flam.CommandConfigDump, "app"}, &output))
+
+			var dump map[string]any
+			require.NoError(t, json.Unmarshal(output.Bytes(), &dump))
+			assert.Equal(t, map[string]any{
+				"auth":  flam.ConfigRedactedValue,
+				"other": "redacted-secret-value"}, dump)
+		})
+	})
+
+	t.Run("should redact the sensitive keys from the resource config errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		harness := flamtest.New(t).MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"redis": flam.Bag{
+					"connections": flam.Bag{
+						"my_connection": flam.Bag{
+							"driver": "invalid",
+							"auth": flam.Bag{
+								"password": "plain-password-value"}}}}}}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		harness.Invoke(func(
+			factory flam.ConfigSourceFactory,
+			redisFactory flam.RedisConnectionFactory,
+		) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			_, e := redisFactory.Get("my_connection")
+			require.ErrorIs(t, e, flam.ErrUnacceptedResourceConfig)
+			assert.NotContains(t, e.Error(), "plain-password-value")
+			assert.Contains(t, e.Error(), flam.ConfigRedactedValue)
+		})
+	})
+
+	t.Run("should redact the sensitive keys from the log contexts", func(t *testing.T) {
+		harness := flamtest.New(t).MustBoot()
+
+		harness.Invoke(func(logger flam.Logger) {
+			logger.SignalError("channel", "message", flam.Bag{
+				"user":  "name",
+				"token": "token-value"})
+		})
+
+		entries := harness.Logs().Entries()
+		require.NotEmpty(t, entries)
+		last := entries[len(entries)-1]
+		assert.Equal(t, "message", last.Message)
+		assert.Equal(t, flam.Bag{"user": "name", "token": flam.ConfigRedactedValue}, last.Context)
+	})
+
+	t.Run("should redact the resolved secret values from the resource errors and logs", func(t *testing.T) {
+		t.Setenv("FLAMTEST_REDACTED_SECRET", "redacted-secret-value")
+
+		ctrl := gomock.NewController(t)
+
+		harness := flamtest.New(t).MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"flam": flam.Bag{
+				"redis": flam.Bag{
+					"connections": flam.Bag{
+						"my_connection": flam.Bag{
+							"driver": "invalid",
+							"uri":    "secret://env/FLAMTEST_REDACTED_SECRET"}}}}}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		harness.Invoke(func(
+			factory flam.ConfigSourceFactory,
+			redisFactory flam.RedisConnectionFactory,
+			logger flam.Logger,
+		) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			_, e := redisFactory.Get("my_connection")
+			require.ErrorIs(t, e, flam.ErrUnacceptedResourceConfig)
+			assert.NotContains(t, e.Error(), "redacted-secret-value")
+			assert.Contains(t, e.Error(), flam.ConfigRedactedValue)
+
+			logger.SignalError("channel", "dsn redacted-secret-value", flam.Bag{"dsn": "redacted-secret-value"})
+		})
+
+		entries := harness.Logs().Entries()
+		require.NotEmpty(t, entries)
+		last := entries[len(entries)-1]
+		assert.Equal(t, "dsn "+flam.ConfigRedactedValue, last.Message)
+		assert.Equal(t, flam.Bag{"dsn": flam.ConfigRedactedValue}, last.Context)
+	})
+
+	t.Run("should only redact the secret values resolved by the same application", func(t *testing.T) {
+		t.Setenv("FLAMTEST_REDACTED_SECRET", "redacted-secret-value")
+
+		ctrl := gomock.NewController(t)
+
+		resolving := flamtest.New(t).MustBoot()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"app": flam.Bag{
+				"dsn": "secret://env/FLAMTEST_REDACTED_SECRET"}}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(100).AnyTimes()
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		resolving.Invoke(func(factory flam.ConfigSourceFactory) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+		})
+
+		other := flamtest.New(t).MustBoot()
+		other.Invoke(func(logger flam.Logger) {
+			logger.SignalError("channel", "dsn redacted-secret-value")
+		})
+
+		entries := other.Logs().Entries()
+		require.NotEmpty(t, entries)
+		assert.Equal(t, "dsn redacted-secret-value", entries[len(entries)-1].Message)
+	})
+}
@@ -0,0 +1,115 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_DatabaseConfigSourceCreator(t *testing.T) {
+	newConfig := func(source flam.Bag) flam.Bag {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathDatabaseConfigs, flam.Bag{
+			"my_config": flam.Bag{
+				"driver": flam.DatabaseConfigDriverDefault}})
+		_ = config.Set(flam.PathDatabaseDialects, flam.Bag{
+			"my_dialect": flam.Bag{
+				"driver": flam.DatabaseDialectDriverSqlite}})
+		_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"dialect_id": "my_dialect",
+				"config_id":  "my_config"}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{"my_source": source})
+
+		return config
+	}
+
+	t.Run("should ignore config without/empty connection_id field", func(t *testing.T) {
+		app := flam.NewApplication(newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": ""}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should ignore config with empty table field", func(t *testing.T) {
+		app := flam.NewApplication(newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": "my_connection",
+			"table":         ""}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should return connection retrieval error", func(t *testing.T) {
+		app := flam.NewApplication(newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": "unknown"}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnknownResource)
+	})
+
+	t.Run("should migrate the configured table if flagged to", func(t *testing.T) {
+		app := flam.NewApplication(newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": "my_connection",
+			"table":         "settings",
+			"migrate":       true}))
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			connection, e := factory.Get("my_connection")
+			require.NoError(t, e)
+
+			assert.True(t, connection.Migrator().HasTable("settings"))
+		}))
+	})
+
+	t.Run("should generate with default table and priority if not given", func(t *testing.T) {
+		config := newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": "my_connection",
+			"migrate":       true})
+		_ = config.Set(flam.PathConfigDefaultPriority, 123)
+		_ = config.Set(flam.PathConfigDefaultDatabaseTable, "settings")
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, connectionFactory flam.DatabaseConnectionFactory) {
+			got, e := factory.Get("my_source")
+			require.NotNil(t, got)
+			require.NoError(t, e)
+
+			assert.Equal(t, 123, got.GetPriority())
+
+			connection, e := connectionFactory.Get("my_connection")
+			require.NoError(t, e)
+
+			assert.True(t, connection.Migrator().HasTable("settings"))
+		}))
+	})
+
+	t.Run("should not be available if the database subsystem is disabled", func(t *testing.T) {
+		config := newConfig(flam.Bag{
+			"driver":        flam.ConfigSourceDriverDatabase,
+			"connection_id": "my_connection"})
+		_ = config.Set(flam.PathSubsystemDatabase, false)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnacceptedResourceConfig)
+	})
+}
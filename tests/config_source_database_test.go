@@ -0,0 +1,260 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_DatabaseConfigSource(t *testing.T) {
+	newConfig := func(source flam.Bag) flam.Bag {
+		source["driver"] = flam.ConfigSourceDriverDatabase
+		source["connection_id"] = "my_connection"
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathDatabaseConfigs, flam.Bag{
+			"my_config": flam.Bag{
+				"driver": flam.DatabaseConfigDriverDefault}})
+		_ = config.Set(flam.PathDatabaseDialects, flam.Bag{
+			"my_dialect": flam.Bag{
+				"driver": flam.DatabaseDialectDriverSqlite}})
+		_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"dialect_id": "my_dialect",
+				"config_id":  "my_config"}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{"my_source": source})
+
+		return config
+	}
+
+	setup := func(t *testing.T, source flam.Bag) flam.Application {
+		app := flam.NewApplication(newConfig(source))
+		require.NoError(t, app.Boot())
+
+		return app
+	}
+
+	insert := func(t *testing.T, app flam.Application, path, value, kind string, updatedAt time.Time) {
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			connection, e := factory.Get("my_connection")
+			require.NoError(t, e)
+
+			require.NoError(t, connection.Exec(
+				"INSERT OR REPLACE INTO __config (path, value, type, updated_at) VALUES (?, ?, ?, ?)",
+				path,
+				value,
+				kind,
+				updatedAt).Error)
+		}))
+	}
+
+	reload := func(t *testing.T, app flam.Application) (flam.ConfigSource, bool, error) {
+		var source flam.ConfigSource
+		var reloaded bool
+		var e error
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e = factory.Get("my_source")
+			require.NoError(t, e)
+
+			reloaded, e = source.(flam.ObservableConfigSource).Reload()
+		}))
+
+		return source, reloaded, e
+	}
+
+	t.Run("should return the missing table error", func(t *testing.T) {
+		app := flam.NewApplication(newConfig(flam.Bag{}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorContains(t, app.Boot(), "no such table")
+	})
+
+	t.Run("should load the typed rows as config paths", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		now := time.Now()
+		insert(t, app, "log.level", "debug", "", now)
+		insert(t, app, "name", "app", flam.ConfigDatabaseTypeString, now)
+		insert(t, app, "port", "8080", flam.ConfigDatabaseTypeInt, now)
+		insert(t, app, "ratio", "0.5", flam.ConfigDatabaseTypeFloat, now)
+		insert(t, app, "enabled", "true", flam.ConfigDatabaseTypeBool, now)
+		insert(t, app, "timeout", "5s", flam.ConfigDatabaseTypeDuration, now)
+		insert(t, app, "list", `[1, "a"]`, flam.ConfigDatabaseTypeJson, now)
+
+		source, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		assert.True(t, reloaded)
+
+		assert.Equal(t, "debug", source.Get("log.level"))
+		assert.Equal(t, "app", source.Get("name"))
+		assert.Equal(t, 8080, source.Get("port"))
+		assert.Equal(t, 0.5, source.Get("ratio"))
+		assert.Equal(t, true, source.Get("enabled"))
+		assert.Equal(t, 5*time.Second, source.Get("timeout"))
+		assert.Equal(t, []any{1, "a"}, source.Get("list"))
+	})
+
+	t.Run("should return the invalid value error", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		insert(t, app, "port", "invalid", flam.ConfigDatabaseTypeInt, time.Now())
+
+		_, reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.ErrorIs(t, e, flam.ErrInvalidDatabaseConfigSourceValue)
+	})
+
+	t.Run("should return the unknown type error", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		insert(t, app, "port", "8080", "unknown", time.Now())
+
+		_, reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.ErrorIs(t, e, flam.ErrInvalidDatabaseConfigSourceValue)
+	})
+
+	t.Run("should no-op reload if no row was updated", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		insert(t, app, "field", "value", "", time.Now())
+
+		_, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		require.True(t, reloaded)
+
+		_, reloaded, e = reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should no-op reload if the updated row value was not changed", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		now := time.Now()
+		insert(t, app, "field", "value", "", now)
+
+		_, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		require.True(t, reloaded)
+
+		insert(t, app, "field", "value", "", now.Add(time.Second))
+
+		_, reloaded, e = reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should reload the rows updated after the high-water mark", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		now := time.Now()
+		insert(t, app, "field", "value", "", now)
+
+		_, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		require.True(t, reloaded)
+
+		insert(t, app, "field", "other", "", now.Add(time.Second))
+
+		source, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		assert.True(t, reloaded)
+		assert.Equal(t, "other", source.Get("field"))
+	})
+
+	t.Run("should reload the removed rows", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		now := time.Now()
+		insert(t, app, "field", "value", "", now)
+		insert(t, app, "other", "value", "", now)
+
+		_, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		require.True(t, reloaded)
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			connection, e := factory.Get("my_connection")
+			require.NoError(t, e)
+
+			require.NoError(t, connection.Exec("DELETE FROM __config WHERE path = ?", "other").Error)
+		}))
+
+		source, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		assert.True(t, reloaded)
+		assert.Equal(t, "value", source.Get("field"))
+		assert.Nil(t, source.Get("other"))
+	})
+
+	t.Run("should reload a removed row replaced by a newer one", func(t *testing.T) {
+		app := setup(t, flam.Bag{"migrate": true})
+		defer func() { _ = app.Close() }()
+
+		now := time.Now()
+		insert(t, app, "field", "value", "", now)
+		insert(t, app, "other", "value", "", now.Add(time.Second))
+
+		_, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		require.True(t, reloaded)
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			connection, e := factory.Get("my_connection")
+			require.NoError(t, e)
+
+			require.NoError(t, connection.Exec("DELETE FROM __config WHERE path = ?", "field").Error)
+		}))
+		insert(t, app, "new", "value", "", now.Add(time.Hour))
+
+		source, reloaded, e := reload(t, app)
+		require.NoError(t, e)
+		assert.True(t, reloaded)
+		assert.Nil(t, source.Get("field"))
+		assert.Equal(t, "value", source.Get("new"))
+	})
+}
+
+func Test_MigrateDatabaseConfigSource(t *testing.T) {
+	t.Run("should create the given table", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathDatabaseConfigs, flam.Bag{
+			"my_config": flam.Bag{
+				"driver": flam.DatabaseConfigDriverDefault}})
+		_ = config.Set(flam.PathDatabaseDialects, flam.Bag{
+			"my_dialect": flam.Bag{
+				"driver": flam.DatabaseDialectDriverSqlite}})
+		_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"dialect_id": "my_dialect",
+				"config_id":  "my_config"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			connection, e := factory.Get("my_connection")
+			require.NoError(t, e)
+
+			require.NoError(t, flam.MigrateDatabaseConfigSource(connection, "settings"))
+
+			assert.True(t, connection.Migrator().HasTable("settings"))
+			assert.True(t, connection.Migrator().HasColumn("settings", "updated_at"))
+		}))
+	})
+}
@@ -2,6 +2,7 @@ package tests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -99,4 +100,105 @@ func Test_envConfigSource(t *testing.T) {
 			assert.Nil(t, source.Get("data"))
 		}))
 	})
+	t.Run("should map the prefixed variables to config paths", func(t *testing.T) {
+		t.Setenv("FLAMTEST__LOG__BOOT", "true")
+		t.Setenv("FLAMTEST__DATA", "value")
+		t.Setenv("FLAMTESTING__DATA", "ignored")
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverEnv,
+				"prefix": "FLAMTEST"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, "true", source.Get("log.boot"))
+			assert.Equal(t, "value", source.Get("data"))
+		}))
+	})
+
+	t.Run("should map the prefixed variables with the selected separator", func(t *testing.T) {
+		t.Setenv("FLAMTEST_LOG_BOOT", "true")
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverEnv,
+				"prefix":    "FLAMTEST",
+				"separator": "_"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, "true", source.Get("log.boot"))
+		}))
+	})
+
+	t.Run("should coerce the values if requested", func(t *testing.T) {
+		t.Setenv("FLAMTEST__BOOL", "True")
+		t.Setenv("FLAMTEST__INT", "123")
+		t.Setenv("FLAMTEST__FLOAT", "1.5")
+		t.Setenv("FLAMTEST__DURATION", "10s")
+		t.Setenv("FLAMTEST__LIST", "[a, 2 , false, b ]")
+		t.Setenv("FLAMTEST__NUMBER", "1,000")
+		t.Setenv("FLAMTEST__ZIP", "0123")
+		t.Setenv("FLAMTEST__NAN", "NaN")
+		t.Setenv("FLAMTEST__INF", "-Inf")
+		t.Setenv("FLAMTEST____EMPTY____SECTIONS", "value")
+		t.Setenv("FLAMTEST__JSON", `{"Nested": {"value": 1}, "list": [1, "b"]}`)
+		t.Setenv("FLAMTEST__STRING", "text")
+		t.Setenv("FLAMTEST_MAPPED", "42")
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverEnv,
+				"prefix": "FLAMTEST",
+				"coerce": true,
+				"mappings": flam.Bag{
+					"FLAMTEST_MAPPED": "mapped"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, true, source.Get("bool"))
+			assert.Equal(t, 123, source.Get("int"))
+			assert.Equal(t, 1.5, source.Get("float"))
+			assert.Equal(t, 10*time.Second, source.Get("duration"))
+			assert.Equal(t, []any{"a", 2, false, "b"}, source.Get("list"))
+			assert.Equal(t, "1,000", source.Get("number"))
+			assert.Equal(t, "0123", source.Get("zip"))
+			assert.Equal(t, "NaN", source.Get("nan"))
+			assert.Equal(t, "-Inf", source.Get("inf"))
+			assert.Equal(t, "value", source.Get("empty.sections"))
+			assert.Equal(t, flam.Bag{
+				"nested": flam.Bag{"value": 1},
+				"list":   []any{1, "b"}}, source.Get("json"))
+			assert.Equal(t, "text", source.Get("string"))
+			assert.Equal(t, 42, source.Get("mapped"))
+		}))
+	})
 }
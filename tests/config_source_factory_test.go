@@ -455,6 +455,65 @@ func Test_ConfigSourceFactory_Store(t *testing.T) {
 			assert.Equal(t, "value", config.Get("field"))
 		}))
 	})
+
+	t.Run("should publish the added source event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, pubSub flam.PubSub[string, string]) {
+			var events [][]any
+			require.NoError(t, pubSub.Subscribe("test", flam.EventConfigSourceAdded, func(_ string, data ...any) error {
+				events = append(events, data)
+				return nil
+			}))
+
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, [][]any{{"my_source"}}, events)
+		}))
+	})
+
+	t.Run("should return source not found when reloading an invalid id", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			assert.ErrorIs(t, factory.Reload("my_source"), flam.ErrUnknownResource)
+		}))
+	})
+
+	t.Run("should only reload the requested sources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock1 := mocks.NewMockObservableConfigSource(ctrl)
+		configSourceMock1.EXPECT().GetPriority().Return(1).AnyTimes()
+		configSourceMock1.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"field": "value1"}).AnyTimes()
+		configSourceMock1.EXPECT().Close().Return(nil)
+
+		configSourceMock2 := mocks.NewMockObservableConfigSource(ctrl)
+		configSourceMock2.EXPECT().GetPriority().Return(2).AnyTimes()
+		configSourceMock2.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"other": "value2"}).AnyTimes()
+		configSourceMock2.EXPECT().Reload().Return(true, nil)
+		configSourceMock2.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			require.NoError(t, factory.Store("my_source_1", configSourceMock1))
+			require.NoError(t, factory.Store("my_source_2", configSourceMock2))
+
+			assert.NoError(t, factory.Reload("my_source_2"))
+		}))
+	})
 }
 
 func Test_ConfigSourceFactory_Remove(t *testing.T) {
@@ -512,6 +571,32 @@ func Test_ConfigSourceFactory_Remove(t *testing.T) {
 			assert.False(t, config.Has("field"))
 		}))
 	})
+
+	t.Run("should publish the removed source event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, pubSub flam.PubSub[string, string]) {
+			var events [][]any
+			require.NoError(t, pubSub.Subscribe("test", flam.EventConfigSourceRemoved, func(_ string, data ...any) error {
+				events = append(events, data)
+				return nil
+			}))
+
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.NoError(t, factory.Remove("my_source"))
+
+			assert.Equal(t, [][]any{{"my_source"}}, events)
+		}))
+	})
 }
 
 func Test_ConfigSourceFactory_RemoveAll(t *testing.T) {
@@ -645,6 +730,33 @@ func Test_ConfigSourceFactory_Reload(t *testing.T) {
 			require.Equal(t, "value2", config.Get("field"))
 		}))
 	})
+
+	t.Run("should publish the reloaded source event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockObservableConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{}).Times(2)
+		configSourceMock.EXPECT().Reload().Return(true, nil)
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, pubSub flam.PubSub[string, string]) {
+			var events [][]any
+			require.NoError(t, pubSub.Subscribe("test", flam.EventConfigSourceReloaded, func(_ string, data ...any) error {
+				events = append(events, data)
+				return nil
+			}))
+
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.NoError(t, factory.Reload())
+
+			assert.Equal(t, [][]any{{"my_source"}}, events)
+		}))
+	})
 }
 
 func Test_ConfigSourceFactory_SetPriority(t *testing.T) {
@@ -687,3 +799,179 @@ func Test_ConfigSourceFactory_SetPriority(t *testing.T) {
 		}))
 	})
 }
+
+func Test_ConfigSourceFactory_Interpolation(t *testing.T) {
+	t.Run("should interpolate env variables with defaults", func(t *testing.T) {
+		t.Setenv("FLAMTEST_HOST", "env.host")
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"host":    "${FLAMTEST_HOST:localhost}",
+			"port":    "${FLAMTEST_PORT:3306}",
+			"empty":   "${FLAMTEST_MISSING:}",
+			"address": "${FLAMTEST_HOST}:${FLAMTEST_PORT:3306}"})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, "env.host", config.Get("host"))
+			assert.Equal(t, "3306", config.Get("port"))
+			assert.Equal(t, "", config.Get("empty"))
+			assert.Equal(t, "env.host:3306", config.Get("address"))
+		}))
+	})
+
+	t.Run("should interpolate references to other config values", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"shared": flam.Bag{
+				"host": "db.host",
+				"port": 3306,
+				"url":  "${shared.host}:${shared.port}"},
+			"database": flam.Bag{
+				"host":    "${shared.host}",
+				"port":    "${shared.port}",
+				"url":     "mysql://${shared.url}",
+				"options": "${shared}",
+				"hosts":   []any{"${shared.host}", "other.host"},
+				"missing": "${shared.missing:default}"}})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.Equal(t, "db.host", config.Get("database.host"))
+			assert.Equal(t, 3306, config.Get("database.port"))
+			assert.Equal(t, "mysql://db.host:3306", config.Get("database.url"))
+			assert.Equal(t, flam.Bag{
+				"host": "db.host",
+				"port": 3306,
+				"url":  "db.host:3306"}, config.Get("database.options"))
+			assert.Equal(t, []any{"db.host", "other.host"}, config.Get("database.hosts"))
+			assert.Equal(t, "default", config.Get("database.missing"))
+		}))
+	})
+
+	t.Run("should interpolate references across sources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		firstConfigSourceMock := mocks.NewMockConfigSource(ctrl)
+		firstConfigSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"host": "db.host"}).AnyTimes()
+		firstConfigSourceMock.EXPECT().GetPriority().Return(0).AnyTimes()
+		firstConfigSourceMock.EXPECT().Close().Return(nil)
+
+		secondConfigSourceMock := mocks.NewMockConfigSource(ctrl)
+		secondConfigSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"url": "${host}/db"}).AnyTimes()
+		secondConfigSourceMock.EXPECT().GetPriority().Return(1).AnyTimes()
+		secondConfigSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source_1", firstConfigSourceMock))
+			require.NoError(t, factory.Store("my_source_2", secondConfigSourceMock))
+
+			assert.Equal(t, "db.host/db", config.Get("url"))
+		}))
+	})
+
+	t.Run("should return a cycle error on circular references", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{
+			"a": "${b}",
+			"b": "prefix ${c}",
+			"c": "${a}"})
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			e := factory.Store("my_source", configSourceMock)
+			assert.ErrorIs(t, e, flam.ErrConfigInterpolationCycle)
+			assert.Nil(t, config.Get("c"))
+		}))
+	})
+
+	t.Run("should return an unresolved reference error on references without value or default", func(t *testing.T) {
+		for _, reference := range []string{"${FLAMTEST_MISSING}", "${missing.path}"} {
+			ctrl := gomock.NewController(t)
+
+			app := flam.NewApplication()
+
+			configSourceMock := mocks.NewMockConfigSource(ctrl)
+			configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"value": reference})
+			configSourceMock.EXPECT().Close().Return(nil)
+
+			assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+				assert.ErrorIs(t, factory.Store("my_source", configSourceMock), flam.ErrUnresolvedConfigInterpolation)
+				assert.Nil(t, config.Get("value"))
+			}))
+
+			_ = app.Close()
+			ctrl.Finish()
+		}
+	})
+
+	t.Run("should keep the previous config when a reload fails to resolve", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockObservableConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"host": "host1", "url": "${host}/db"})
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"host": "host2", "url": "${FLAMTEST_MISSING}/db"})
+		configSourceMock.EXPECT().Reload().Return(true, nil)
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+
+			assert.ErrorIs(t, factory.Reload(), flam.ErrUnresolvedConfigInterpolation)
+			assert.Equal(t, "host1", config.Get("host"))
+			assert.Equal(t, "host1/db", config.Get("url"))
+		}))
+	})
+
+	t.Run("should re-resolve the references when an observable source reloads", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		configSourceMock := mocks.NewMockObservableConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"host": "host1", "url": "${host}/db"})
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{"host": "host2", "url": "${host}/db"})
+		configSourceMock.EXPECT().Reload().Return(true, nil)
+		configSourceMock.EXPECT().Close().Return(nil)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory, config flam.Config) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+			require.Equal(t, "host1/db", config.Get("url"))
+
+			assert.NoError(t, factory.Reload())
+			assert.Equal(t, "host2/db", config.Get("url"))
+		}))
+	})
+}
@@ -0,0 +1,56 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_flagsConfigSourceCreator(t *testing.T) {
+	t.Run("should correctly instantiate the source with default values", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverFlags}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, 0, source.GetPriority())
+			assert.Equal(t, flam.Bag{}, source.Get(""))
+		}))
+	})
+
+	t.Run("should correctly instantiate the source with selected priority and args", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":   flam.ConfigSourceDriverFlags,
+				"priority": 100,
+				"args":     []string{"--data=value"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, 100, source.GetPriority())
+			assert.Equal(t, "value", source.Get("data"))
+		}))
+	})
+}
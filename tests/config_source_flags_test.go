@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_flagsConfigSource(t *testing.T) {
+	t.Run("should load the flags into config paths", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverFlags,
+				"args": []string{
+					"positional",
+					"--log.boot=true",
+					"--data=",
+					"-short=ignored",
+					"--verbose",
+					"--name",
+					"value",
+					"--retries",
+					"-5",
+					"--offset=-3",
+					"--",
+					"--after=ignored"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, "true", source.Get("log.boot"))
+			assert.Equal(t, "", source.Get("data"))
+			assert.Equal(t, true, source.Get("verbose"))
+			assert.Equal(t, "value", source.Get("name"))
+			assert.Equal(t, "-5", source.Get("retries"))
+			assert.Equal(t, "-3", source.Get("offset"))
+			assert.Nil(t, source.Get("-short"))
+			assert.Nil(t, source.Get("after"))
+		}))
+	})
+
+	t.Run("should load the process arguments if requested", func(t *testing.T) {
+		args := os.Args
+		os.Args = []string{"app", "--from.os=value", "--port", "8080"}
+		defer func() { os.Args = args }()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":  flam.ConfigSourceDriverFlags,
+				"os_args": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, "value", source.Get("from.os"))
+			assert.Equal(t, "8080", source.Get("port"))
+		}))
+	})
+
+	t.Run("should build lists from repeated flags", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverFlags,
+				"args": []string{
+					"--list=a",
+					"--list=b",
+					"--list=c"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, []any{"a", "b", "c"}, source.Get("list"))
+		}))
+	})
+
+	t.Run("should coerce the values if requested", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver": flam.ConfigSourceDriverFlags,
+				"coerce": true,
+				"args": []string{
+					"--log.boot=false",
+					"--port=8080",
+					"--ratio=0.5",
+					"--timeout=2m",
+					"--names=[a,b]",
+					"--amount=1,000",
+					"--Camel.Key=value",
+					"--ids=1",
+					"--ids=[2,3]",
+					"--single=[4]",
+					"--level",
+					"debug",
+					"--delta",
+					"-5",
+					`--nested={"key": "value"}`}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			assert.NotNil(t, source)
+			assert.NoError(t, e)
+
+			assert.Equal(t, false, source.Get("log.boot"))
+			assert.Equal(t, 8080, source.Get("port"))
+			assert.Equal(t, 0.5, source.Get("ratio"))
+			assert.Equal(t, 2*time.Minute, source.Get("timeout"))
+			assert.Equal(t, []any{"a", "b"}, source.Get("names"))
+			assert.Equal(t, "1,000", source.Get("amount"))
+			assert.Equal(t, "value", source.Get("camel.key"))
+			assert.Equal(t, []any{1, 2, 3}, source.Get("ids"))
+			assert.Equal(t, []any{4}, source.Get("single"))
+			assert.Equal(t, "debug", source.Get("level"))
+			assert.Equal(t, -5, source.Get("delta"))
+			assert.Equal(t, flam.Bag{"key": "value"}, source.Get("nested"))
+		}))
+	})
+
+	t.Run("should override lower priority sources in the aggregated config", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":   flam.ConfigSourceDriverFlags,
+				"priority": 100,
+				"coerce":   true,
+				"args":     []string{"--flam.health.timeout=5s"}}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, 5*time.Second, config.Duration(flam.PathHealthTimeout))
+		}))
+	})
+}
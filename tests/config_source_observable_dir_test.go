@@ -0,0 +1,248 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_ObservableDirConfigSource(t *testing.T) {
+	t.Run("should return dir opening error", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverYaml}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableDir,
+				"disk_id":   "my_disk",
+				"path":      "/testdata",
+				"parser_id": "my_parser",
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", afero.NewMemMapFs()))
+		}))
+
+		assert.ErrorContains(t, app.Boot(), "file does not exist")
+	})
+
+	t.Run("should return dir reading error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverYaml}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableDir,
+				"disk_id":   "my_disk",
+				"path":      "/testdata",
+				"parser_id": "my_parser",
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("dir error")
+		dirMock := mocks.NewMockFile(ctrl)
+		dirMock.EXPECT().Readdir(0).Return(nil, expectedErr)
+		dirMock.EXPECT().Close().Return(nil)
+
+		diskMock := mocks.NewMockDisk(ctrl)
+		diskMock.EXPECT().Open("/testdata").Return(dirMock, nil)
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", diskMock))
+		}))
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+	})
+
+	t.Run("should load the dir files", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverYaml}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableDir,
+				"disk_id":   "my_disk",
+				"path":      "/testdata",
+				"parser_id": "my_parser",
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			disk := afero.NewMemMapFs()
+			require.NoError(t, afero.WriteFile(disk, "/testdata/first.yaml", []byte("first: value"), 0o644))
+			require.NoError(t, afero.WriteFile(disk, "/testdata/second.yaml", []byte("second: value"), 0o644))
+			require.NoError(t, factory.Store("my_disk", disk))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "value", config.Get("first"))
+			assert.Equal(t, "value", config.Get("second"))
+		}))
+	})
+}
+
+func Test_ObservableDirConfigSource_Reload(t *testing.T) {
+	setup := func(t *testing.T, recursive bool) (flam.Application, afero.Fs) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverYaml}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableDir,
+				"disk_id":   "my_disk",
+				"path":      "/testdata",
+				"parser_id": "my_parser",
+				"recursive": recursive,
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+
+		disk := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(disk, "/testdata/first.yaml", []byte("first: value"), 0o644))
+		require.NoError(t, afero.WriteFile(disk, "/testdata/sub/second.yaml", []byte("second: value"), 0o644))
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_disk", disk))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		return app, disk
+	}
+
+	reload := func(t *testing.T, app flam.Application) (bool, error) {
+		var reloaded bool
+		var e error
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, err := factory.Get("my_source")
+			require.NoError(t, err)
+
+			reloaded, e = source.(flam.ObservableConfigSource).Reload()
+		}))
+
+		return reloaded, e
+	}
+
+	read := func(t *testing.T, app flam.Application) flam.Bag {
+		var bag flam.Bag
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			require.NoError(t, e)
+
+			bag, _ = source.Get("").(flam.Bag)
+		}))
+
+		return bag
+	}
+
+	t.Run("should no-op if no file was updated", func(t *testing.T) {
+		app, _ := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should reload if a file was updated", func(t *testing.T) {
+		app, disk := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		future := time.Now().AddDate(1, 0, 0)
+		require.NoError(t, afero.WriteFile(disk, "/testdata/first.yaml", []byte("first: other"), 0o644))
+		require.NoError(t, disk.Chtimes("/testdata/first.yaml", future, future))
+
+		reloaded, e := reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+		assert.Equal(t, flam.Bag{"first": "other"}, read(t, app))
+	})
+
+	t.Run("should reload if a file was added", func(t *testing.T) {
+		app, disk := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, afero.WriteFile(disk, "/testdata/third.yaml", []byte("third: value"), 0o644))
+
+		reloaded, e := reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+		assert.Equal(t, flam.Bag{"first": "value", "third": "value"}, read(t, app))
+	})
+
+	t.Run("should reload if a file was removed", func(t *testing.T) {
+		app, disk := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, disk.Remove("/testdata/first.yaml"))
+
+		reloaded, e := reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+		assert.Equal(t, flam.Bag{}, read(t, app))
+	})
+
+	t.Run("should ignore sub-directory changes if not flagged as recursive", func(t *testing.T) {
+		app, disk := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, afero.WriteFile(disk, "/testdata/sub/third.yaml", []byte("third: value"), 0o644))
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should reload sub-directory changes if flagged as recursive", func(t *testing.T) {
+		app, disk := setup(t, true)
+		defer func() { _ = app.Close() }()
+
+		assert.Equal(t, flam.Bag{"first": "value", "second": "value"}, read(t, app))
+
+		require.NoError(t, afero.WriteFile(disk, "/testdata/sub/third.yaml", []byte("third: value"), 0o644))
+
+		reloaded, e := reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+		assert.Equal(t, flam.Bag{"first": "value", "second": "value", "third": "value"}, read(t, app))
+	})
+
+	t.Run("should return dir opening error", func(t *testing.T) {
+		app, disk := setup(t, false)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, disk.RemoveAll("/testdata"))
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.ErrorContains(t, e, "file does not exist")
+	})
+}
@@ -35,6 +35,69 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
 	})
 
+	t.Run("should ignore config with an unknown mode", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableRest,
+				"uri":       "http://path/",
+				"parser_id": "my_parser",
+				"mode":      "unknown",
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should not require the paths in conditional mode", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigDefaultRestConfigPath, "")
+		_ = config.Set(flam.PathConfigDefaultRestTimestampPath, "")
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableRest,
+				"uri":       "http://path/",
+				"parser_id": "my_parser",
+				"mode":      flam.ConfigSourceRestModeConditional,
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		data := `{"field": "value"}`
+		reader := func(b []byte) (int, error) {
+			copy(b, data)
+			return len(data), io.EOF
+		}
+
+		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
+		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
+
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
+
+		requesterMock := mocks.NewMockConfigRestClient(ctrl)
+		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
+
+		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
+		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
+			return requesterGeneratorMock
+		})
+
+		assert.NoError(t, app.Boot())
+	})
+
 	t.Run("should ignore config without/empty parser_id field", func(t *testing.T) {
 		config := flam.Bag{}
 		_ = config.Set(flam.PathConfigBoot, true)
@@ -113,7 +176,7 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 
 		expectedErr := errors.New("requester error")
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(nil, expectedErr)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(nil, expectedErr)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -143,7 +206,7 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -182,15 +245,16 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -236,15 +300,16 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -282,15 +347,16 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -328,15 +394,16 @@ func Test_ObservableRestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
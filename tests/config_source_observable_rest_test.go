@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -44,7 +46,7 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		requesterMock.EXPECT().Do(gomock.Any()).Return(nil, expectedErr)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -76,15 +78,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 
 		expectedErr := errors.New("requester error")
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).Return(0, expectedErr)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -121,15 +124,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -166,15 +170,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -211,15 +216,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -256,15 +262,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -300,15 +307,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -345,15 +353,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -390,15 +399,16 @@ func Test_ObservableRestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -445,9 +455,10 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		expectedErr := errors.New("requester error")
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
@@ -455,7 +466,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		requesterMock.EXPECT().Do(gomock.Any()).Return(nil, expectedErr)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -504,22 +515,24 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
 		expectedErr := errors.New("reader error")
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).Return(0, expectedErr)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -568,6 +581,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := "{"
@@ -577,18 +591,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -637,6 +652,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := "{}"
@@ -646,18 +662,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -706,6 +723,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := "{\"timestamp\": 1234567890}"
@@ -715,18 +733,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -775,6 +794,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := "{\"timestamp\": \"invalid\"}"
@@ -784,18 +804,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -844,6 +865,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := fmt.Sprintf(`{"timestamp": "%s"}`, time.Now().Add(time.Hour*25).Format(time.RFC3339))
@@ -853,18 +875,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -913,6 +936,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := fmt.Sprintf(
@@ -924,18 +948,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -984,6 +1009,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := fmt.Sprintf(`{"timestamp": "%s", "config": {"field": "value2"}}`, time.Now().Add(time.Hour*25).Format(time.RFC3339))
@@ -993,18 +1019,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -1052,6 +1079,7 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body1Mock := mocks.NewMockReadCloser(ctrl)
+		body1Mock.EXPECT().Close().Return(nil)
 		body1Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader1)
 
 		data2 := fmt.Sprintf(`{"timestamp": "%s", "config": {"field": "value2"}}`, time.Now().Add(time.Hour*23).Format(time.RFC3339))
@@ -1061,18 +1089,19 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}
 
 		body2Mock := mocks.NewMockReadCloser(ctrl)
+		body2Mock.EXPECT().Close().Return(nil)
 		body2Mock.EXPECT().Read(gomock.Any()).DoAndReturn(reader2)
 
-		response1 := &http.Response{Body: body1Mock}
+		response1 := &http.Response{StatusCode: http.StatusOK, Body: body1Mock}
 
-		response2 := &http.Response{Body: body2Mock}
+		response2 := &http.Response{StatusCode: http.StatusOK, Body: body2Mock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response1, nil)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response2, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -1092,3 +1121,142 @@ func Test_ObservableRestConfigSource_Reload(t *testing.T) {
 		}))
 	})
 }
+
+func Test_ObservableRestConfigSource_Conditional(t *testing.T) {
+	setup := func(t *testing.T, uri string) flam.Application {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverObservableRest,
+				"uri":       uri,
+				"parser_id": "my_parser",
+				"mode":      flam.ConfigSourceRestModeConditional,
+				"priority":  123}})
+
+		app := flam.NewApplication(config)
+		require.NoError(t, app.Boot())
+
+		return app
+	}
+
+	reload := func(t *testing.T, app flam.Application) (bool, error) {
+		var reloaded bool
+		var e error
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, err := factory.Get("my_source")
+			require.NoError(t, err)
+
+			reloaded, e = source.(flam.ObservableConfigSource).Reload()
+		}))
+
+		return reloaded, e
+	}
+
+	t.Run("should use the etag to detect changes", func(t *testing.T) {
+		version := atomic.Int32{}
+		version.Store(1)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			etag := fmt.Sprintf(`"v%d"`, version.Load())
+			if request.Header.Get("If-None-Match") == etag {
+				writer.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			writer.Header().Set("ETag", etag)
+			_, _ = fmt.Fprintf(writer, `{"field": %d}`, version.Load())
+		}))
+		defer server.Close()
+
+		app := setup(t, server.URL)
+		defer func() { _ = app.Close() }()
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+
+		version.Store(2)
+
+		reloaded, e = reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, e := factory.Get("my_source")
+			require.NoError(t, e)
+
+			assert.Equal(t, 2, source.Get("field"))
+		}))
+	})
+
+	t.Run("should use the last modified date to detect changes", func(t *testing.T) {
+		modified := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if since, e := http.ParseTime(request.Header.Get("If-Modified-Since")); e == nil && !modified.After(since) {
+				writer.WriteHeader(http.StatusNotModified)
+				return
+			}
+
+			writer.Header().Set("Last-Modified", modified.Format(http.TimeFormat))
+			_, _ = writer.Write([]byte(`{"field": "value"}`))
+		}))
+		defer server.Close()
+
+		app := setup(t, server.URL)
+		defer func() { _ = app.Close() }()
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should compare the payload if the server sends no validators", func(t *testing.T) {
+		value := atomic.Value{}
+		value.Store("value")
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Empty(t, request.Header.Get("If-None-Match"))
+			assert.Empty(t, request.Header.Get("If-Modified-Since"))
+
+			_, _ = fmt.Fprintf(writer, `{"field": %q}`, value.Load())
+		}))
+		defer server.Close()
+
+		app := setup(t, server.URL)
+		defer func() { _ = app.Close() }()
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+
+		value.Store("other")
+
+		reloaded, e = reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should return the status error", func(t *testing.T) {
+		failing := atomic.Bool{}
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			if failing.Load() {
+				writer.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			_, _ = writer.Write([]byte(`{"field": "value"}`))
+		}))
+		defer server.Close()
+
+		app := setup(t, server.URL)
+		defer func() { _ = app.Close() }()
+
+		failing.Store(true)
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.ErrorIs(t, e, flam.ErrRestConfigSourceStatus)
+	})
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_RedisConfigSourceCreator(t *testing.T) {
+	newConfig := func(addr string, source flam.Bag) flam.Bag {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini,
+				"host":   addr}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{"my_source": source})
+
+		return config
+	}
+
+	t.Run("should ignore config without/empty connection_id field", func(t *testing.T) {
+		app := flam.NewApplication(newConfig("", flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "",
+			"key":           "config"}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should ignore config without key and prefix fields", func(t *testing.T) {
+		app := flam.NewApplication(newConfig("", flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "my_connection"}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should ignore config with both key and prefix fields", func(t *testing.T) {
+		app := flam.NewApplication(newConfig("", flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "my_connection",
+			"key":           "config",
+			"prefix":        "config:"}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrInvalidResourceConfig)
+	})
+
+	t.Run("should return connection retrieval error", func(t *testing.T) {
+		app := flam.NewApplication(newConfig("", flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "unknown",
+			"key":           "config"}))
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnknownResource)
+	})
+
+	t.Run("should generate with default priority if not given", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+
+		config := newConfig(mini.Addr(), flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "my_connection",
+			"key":           "config"})
+		_ = config.Set(flam.PathConfigDefaultPriority, 123)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			got, e := factory.Get("my_source")
+			require.NotNil(t, got)
+			require.NoError(t, e)
+
+			assert.Equal(t, 123, got.GetPriority())
+		}))
+	})
+
+	t.Run("should not be available if the redis subsystem is disabled", func(t *testing.T) {
+		config := newConfig("", flam.Bag{
+			"driver":        flam.ConfigSourceDriverRedis,
+			"connection_id": "my_connection",
+			"key":           "config"})
+		_ = config.Set(flam.PathSubsystemRedis, false)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrUnacceptedResourceConfig)
+	})
+}
@@ -0,0 +1,210 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_RedisConfigSource(t *testing.T) {
+	setup := func(t *testing.T, mini *miniredis.Miniredis, source flam.Bag) flam.Application {
+		source["driver"] = flam.ConfigSourceDriverRedis
+		source["connection_id"] = "my_connection"
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini,
+				"host":   mini.Addr()}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{"my_source": source})
+
+		app := flam.NewApplication(config)
+		require.NoError(t, app.Boot())
+
+		return app
+	}
+
+	reload := func(t *testing.T, app flam.Application) (bool, error) {
+		var reloaded bool
+		var e error
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			source, err := factory.Get("my_source")
+			require.NoError(t, err)
+
+			reloaded, e = source.(flam.ObservableConfigSource).Reload()
+		}))
+
+		return reloaded, e
+	}
+
+	t.Run("should return the connection error", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		addr := mini.Addr()
+		mini.Close()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini,
+				"host":   addr}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":        flam.ConfigSourceDriverRedis,
+				"connection_id": "my_connection",
+				"key":           "config"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		assert.ErrorContains(t, app.Boot(), "connection refused")
+	})
+
+	t.Run("should load the hash fields as config paths", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		mini.HSet("config", "log.level", "debug", "timeout", "5")
+
+		app := setup(t, mini, flam.Bag{"key": "config"})
+		defer func() { _ = app.Close() }()
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "debug", config.Get("log.level"))
+			assert.Equal(t, "5", config.Get("timeout"))
+		}))
+	})
+
+	t.Run("should coerce the values if flagged to", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		mini.HSet("config", "enabled", "true", "timeout", "5")
+
+		app := setup(t, mini, flam.Bag{"key": "config", "coerce": true})
+		defer func() { _ = app.Close() }()
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, true, config.Get("enabled"))
+			assert.Equal(t, 5, config.Get("timeout"))
+		}))
+	})
+
+	t.Run("should load the prefixed keys as config paths", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		require.NoError(t, mini.Set("config:log.level", "debug"))
+		require.NoError(t, mini.Set("config:timeout", "5"))
+		require.NoError(t, mini.Set("other", "value"))
+
+		app := setup(t, mini, flam.Bag{"prefix": "config:"})
+		defer func() { _ = app.Close() }()
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "debug", config.Get("log.level"))
+			assert.Equal(t, "5", config.Get("timeout"))
+			assert.Nil(t, config.Get("other"))
+		}))
+	})
+
+	t.Run("should no-op reload if the data was not changed", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		mini.HSet("config", "field", "value")
+
+		app := setup(t, mini, flam.Bag{"key": "config"})
+		defer func() { _ = app.Close() }()
+
+		reloaded, e := reload(t, app)
+		assert.False(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should reload the changed data", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		mini.HSet("config", "field", "value")
+
+		app := setup(t, mini, flam.Bag{"key": "config"})
+		defer func() { _ = app.Close() }()
+
+		mini.HSet("config", "field", "other")
+
+		reloaded, e := reload(t, app)
+		assert.True(t, reloaded)
+		assert.NoError(t, e)
+	})
+
+	t.Run("should reload on a channel message", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		mini.HSet("config", "field", "value")
+
+		app := setup(t, mini, flam.Bag{"key": "config", "channel": "config:changed"})
+		defer func() { _ = app.Close() }()
+
+		mini.HSet("config", "field", "other")
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				mini.Publish("config:changed", "")
+				return config.Get("field") == "other"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+
+	t.Run("should reload on a keyspace notification", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		require.NoError(t, mini.Set("config:field", "value"))
+
+		app := setup(t, mini, flam.Bag{"prefix": "config:", "keyspace": true})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, mini.Set("config:field", "other"))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				mini.Publish("__keyspace@0__:config:field", "set")
+				return config.Get("field") == "other"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+
+	t.Run("should only reload on the keyspace notifications of the connection database", func(t *testing.T) {
+		mini := miniredis.RunT(t)
+		require.NoError(t, mini.DB(2).Set("cfg[1]:field", "value"))
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigObserverFrequency, time.Hour)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini,
+				"host":   mini.Addr(),
+				"db":     2}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":        flam.ConfigSourceDriverRedis,
+				"connection_id": "my_connection",
+				"prefix":        "cfg[1]:",
+				"keyspace":      true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, mini.DB(2).Set("cfg[1]:field", "other"))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Never(t, func() bool {
+				mini.Publish("__keyspace@0__:cfg[1]:field", "set")
+				mini.Publish("__keyspace@2__:cfg1:field", "set")
+				return config.Get("field") == "other"
+			}, 100*time.Millisecond, 10*time.Millisecond)
+
+			assert.Eventually(t, func() bool {
+				mini.Publish("__keyspace@2__:cfg[1]:field", "set")
+				return config.Get("field") == "other"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+}
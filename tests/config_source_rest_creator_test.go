@@ -89,7 +89,7 @@ func Test_RestConfigSourceCreator(t *testing.T) {
 
 		expectedErr := errors.New("requester error")
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(nil, expectedErr)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(nil, expectedErr)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -164,15 +164,16 @@ func Test_RestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -215,15 +216,16 @@ func Test_RestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -257,15 +259,16 @@ func Test_RestConfigSourceCreator(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
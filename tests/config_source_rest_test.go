@@ -4,7 +4,9 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -41,7 +43,7 @@ func Test_RestConfigSource(t *testing.T) {
 		requesterMock.EXPECT().Do(gomock.Any()).Return(nil, expectedErr)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -72,15 +74,16 @@ func Test_RestConfigSource(t *testing.T) {
 
 		expectedErr := errors.New("requester error")
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).Return(0, expectedErr)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -116,15 +119,16 @@ func Test_RestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -160,15 +164,16 @@ func Test_RestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -204,15 +209,16 @@ func Test_RestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -248,15 +254,16 @@ func Test_RestConfigSource(t *testing.T) {
 		}
 
 		bodyMock := mocks.NewMockReadCloser(ctrl)
+		bodyMock.EXPECT().Close().Return(nil)
 		bodyMock.EXPECT().Read(gomock.Any()).DoAndReturn(reader)
 
-		response := &http.Response{Body: bodyMock}
+		response := &http.Response{StatusCode: http.StatusOK, Body: bodyMock}
 
 		requesterMock := mocks.NewMockConfigRestClient(ctrl)
 		requesterMock.EXPECT().Do(gomock.Any()).Return(response, nil)
 
 		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
-		requesterGeneratorMock.EXPECT().Create().Return(requesterMock, nil)
+		requesterGeneratorMock.EXPECT().Create(gomock.Any()).Return(requesterMock, nil)
 		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return requesterGeneratorMock
 		})
@@ -271,4 +278,100 @@ func Test_RestConfigSource(t *testing.T) {
 			assert.Equal(t, "value", got.Get("field"))
 		}))
 	})
+
+	t.Run("should generate the client with the source client config", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverRest,
+				"uri":       "http://path/",
+				"parser_id": "my_parser",
+				"path": flam.Bag{
+					"config": "config"},
+				"client": flam.Bag{
+					"timeout": 5 * time.Second},
+				"priority": 123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("generator error")
+		requesterGeneratorMock := mocks.NewMockConfigRestClientGenerator(ctrl)
+		requesterGeneratorMock.EXPECT().Create(flam.Bag{"timeout": 5 * time.Second}).Return(nil, expectedErr)
+		_ = app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
+			return requesterGeneratorMock
+		})
+
+		assert.ErrorIs(t, app.Boot(), expectedErr)
+	})
+
+	t.Run("should return a status error on a non-success response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = writer.Write([]byte("maintenance"))
+		}))
+		defer server.Close()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverRest,
+				"uri":       server.URL,
+				"parser_id": "my_parser",
+				"path": flam.Bag{
+					"config": "config"},
+				"priority": 123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrRestConfigSourceStatus)
+		assert.ErrorContains(t, e, "503 Service Unavailable: maintenance")
+	})
+
+	t.Run("should load the config from a server", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			assert.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+			_, _ = writer.Write([]byte(`{"config": {"field": "value"}}`))
+		}))
+		defer server.Close()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverJson}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{
+			"my_source": flam.Bag{
+				"driver":    flam.ConfigSourceDriverRest,
+				"uri":       server.URL,
+				"parser_id": "my_parser",
+				"path": flam.Bag{
+					"config": "config"},
+				"client": flam.Bag{
+					"auth": flam.Bag{
+						"token": "token"}},
+				"priority": 123}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "value", config.Get("field"))
+		}))
+	})
 }
@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_ConfigWatcher(t *testing.T) {
+	newConfig := func(enabled bool, source flam.Bag) flam.Bag {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathConfigBoot, true)
+		_ = config.Set(flam.PathConfigObserverFrequency, time.Hour)
+		_ = config.Set(flam.PathConfigWatcherEnabled, enabled)
+		_ = config.Set(flam.PathConfigWatcherDebounce, 10*time.Millisecond)
+		_ = config.Set(flam.PathDisks, flam.Bag{
+			"my_disk": flam.Bag{
+				"driver": flam.DiskDriverOS}})
+		_ = config.Set(flam.PathConfigParsers, flam.Bag{
+			"my_parser": flam.Bag{
+				"driver": flam.ConfigParserDriverYaml}})
+		_ = config.Set(flam.PathConfigSources, flam.Bag{"my_source": source})
+
+		return config
+	}
+
+	t.Run("should not reload watched sources if the watcher is not enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("field: value"), 0o644))
+
+		app := flam.NewApplication(newConfig(false, flam.Bag{
+			"driver":    flam.ConfigSourceDriverObservableFile,
+			"disk_id":   "my_disk",
+			"path":      path,
+			"parser_id": "my_parser"}))
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, os.WriteFile(path, []byte("field: other"), 0o644))
+		time.Sleep(100 * time.Millisecond)
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Equal(t, "value", config.Get("field"))
+		}))
+	})
+
+	t.Run("should reload an os disk file source on change", func(t *testing.T) {
+		dir := t.TempDir()
+		path := filepath.Join(dir, "config.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("field: value"), 0o644))
+
+		app := flam.NewApplication(newConfig(true, flam.Bag{
+			"driver":    flam.ConfigSourceDriverObservableFile,
+			"disk_id":   "my_disk",
+			"path":      path,
+			"parser_id": "my_parser"}))
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		future := time.Now().Add(time.Hour)
+		require.NoError(t, os.WriteFile(path, []byte("field: other"), 0o644))
+		require.NoError(t, os.Chtimes(path, future, future))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				return config.Get("field") == "other"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+
+	t.Run("should reload an os disk recursive dir source on a new sub-directory file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("field: value"), 0o644))
+
+		app := flam.NewApplication(newConfig(true, flam.Bag{
+			"driver":    flam.ConfigSourceDriverObservableDir,
+			"disk_id":   "my_disk",
+			"path":      dir,
+			"parser_id": "my_parser",
+			"recursive": true}))
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "other.yaml"), []byte("other: value"), 0o644))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				return config.Get("other") == "value"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+
+	t.Run("should refresh the watched directories after a sub-directory removal", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "config.yaml"), []byte("field: value"), 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "other.yaml"), []byte("other: value"), 0o644))
+
+		app := flam.NewApplication(newConfig(true, flam.Bag{
+			"driver":    flam.ConfigSourceDriverObservableDir,
+			"disk_id":   "my_disk",
+			"path":      dir,
+			"parser_id": "my_parser",
+			"recursive": true}))
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, os.RemoveAll(filepath.Join(dir, "sub")))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				return config.Get("other") == nil
+			}, time.Second, 10*time.Millisecond)
+		}))
+
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "new"), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "new", "other.yaml"), []byte("other: new"), 0o644))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				return config.Get("other") == "new"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+
+	t.Run("should keep polling sources of non os disks", func(t *testing.T) {
+		config := newConfig(true, flam.Bag{
+			"driver":    flam.ConfigSourceDriverObservableFile,
+			"disk_id":   "my_memory_disk",
+			"path":      "/config.yaml",
+			"parser_id": "my_parser"})
+		_ = config.Set(flam.PathConfigObserverFrequency, 10*time.Millisecond)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		disk := afero.NewMemMapFs()
+		require.NoError(t, afero.WriteFile(disk, "/config.yaml", []byte("field: value"), 0o644))
+		require.NoError(t, app.Container().Invoke(func(factory flam.DiskFactory) {
+			require.NoError(t, factory.Store("my_memory_disk", disk))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		future := time.Now().Add(time.Hour)
+		require.NoError(t, afero.WriteFile(disk, "/config.yaml", []byte("field: other"), 0o644))
+		require.NoError(t, disk.Chtimes("/config.yaml", future, future))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
+			assert.Eventually(t, func() bool {
+				return config.Get("field") == "other"
+			}, time.Second, 10*time.Millisecond)
+		}))
+	})
+}
@@ -0,0 +1,195 @@
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+type containerMissingDependency struct{}
+
+type containerDependent struct{}
+
+func newContainerDependent(*containerMissingDependency) *containerDependent {
+	return &containerDependent{}
+}
+
+func Test_Application_Registrations(t *testing.T) {
+	t.Run("should list the core provider registrations", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		entries := app.Registrations()
+		require.NotEmpty(t, entries)
+
+		var found bool
+		for _, entry := range entries {
+			assert.Equal(t, "flam.provider", entry.Provider)
+			if entry.Constructor == "flam-in-go.newConsoleLogStreamCreator" {
+				found = true
+				assert.Equal(t, []string{"flam.Config", "flam.LogSerializerFactory"}, entry.Inputs)
+				assert.Equal(t, []string{`flam.LogStreamCreator[group = "flam.log.streams.creator"]`}, entry.Outputs)
+				assert.Equal(t, []string{flam.LogStreamCreatorGroup}, entry.Groups)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	t.Run("should filter the registrations by provider", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).DoAndReturn(func(container *dig.Container) error {
+			return flam.NewRegisterer().
+				Queue(newContainerDependent).
+				Run(container)
+		})
+		require.NoError(t, app.Register(providerMock))
+
+		assert.Equal(t, []flam.ContainerEntry{{
+			Provider:    "provider",
+			Constructor: "tests.newContainerDependent",
+			Inputs:      []string{"*tests.containerMissingDependency"},
+			Outputs:     []string{"*tests.containerDependent"}}}, app.Registrations("provider"))
+		assert.Empty(t, app.Registrations("unknown"))
+	})
+}
+
+func Test_Application_Graph(t *testing.T) {
+	t.Run("should export the container graph in dot format", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		buffer := &bytes.Buffer{}
+		require.NoError(t, app.Graph(buffer))
+		assert.Contains(t, buffer.String(), "digraph {")
+		assert.Contains(t, buffer.String(), "flam.Config")
+	})
+}
+
+func Test_Application_Verify(t *testing.T) {
+	t.Run("should verify the core provider registrations", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		assert.NoError(t, app.Verify())
+	})
+
+	t.Run("should report all the missing dependencies", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).DoAndReturn(func(container *dig.Container) error {
+			return flam.NewRegisterer().
+				Queue(newContainerDependent).
+				Queue(func(*containerDependent, *containerMissingDependency) int { return 0 }).
+				Run(container)
+		})
+		require.NoError(t, app.Register(providerMock))
+
+		e := app.Verify()
+		assert.ErrorIs(t, e, flam.ErrContainerVerificationFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		require.Equal(t, 2, multiErr.Len())
+		assert.Equal(t, "provider: tests.newContainerDependent", multiErr.Entries()[0].Origin)
+		assert.Equal(t, "provider: tests.Test_Application_Verify", multiErr.Entries()[1].Origin)
+	})
+
+	t.Run("should report the constructors depending on a non provided database connection", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).DoAndReturn(func(container *dig.Container) error {
+			return flam.NewRegisterer().
+				Queue(func(flam.DatabaseConnection) int { return 0 }).
+				Run(container)
+		})
+		require.NoError(t, app.Register(providerMock))
+
+		e := app.Verify()
+		assert.ErrorIs(t, e, flam.ErrContainerVerificationFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		require.Equal(t, 1, multiErr.Len())
+	})
+
+	t.Run("should verify the container on boot if flagged to do so", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathContainerVerify, true)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		providerMock := mocks.NewMockProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).DoAndReturn(func(container *dig.Container) error {
+			return flam.NewRegisterer().
+				Queue(newContainerDependent).
+				Run(container)
+		})
+		require.NoError(t, app.Register(providerMock))
+
+		assert.ErrorIs(t, app.Boot(), flam.ErrContainerVerificationFailed)
+	})
+
+	t.Run("should verify the container on boot after the providers boot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathContainerVerify, true)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		booted := false
+		constructedAfterBoot := false
+		providerMock := mocks.NewMockBootableProvider(ctrl)
+		providerMock.EXPECT().Id().Return("provider").AnyTimes()
+		providerMock.EXPECT().Register(gomock.Any()).DoAndReturn(func(container *dig.Container) error {
+			return flam.NewRegisterer().
+				Queue(func() int {
+					constructedAfterBoot = booted
+					return 0
+				}).
+				Queue(func(int) string { return "" }).
+				Run(container)
+		})
+		providerMock.EXPECT().Boot(gomock.Any()).DoAndReturn(func(*dig.Container) error {
+			booted = true
+			return nil
+		})
+		require.NoError(t, app.Register(providerMock))
+
+		require.NoError(t, app.Boot())
+		assert.True(t, constructedAfterBoot)
+	})
+}
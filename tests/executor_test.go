@@ -10,6 +10,10 @@ import (
 	"github.com/cjdias/flam-in-go"
 )
 
+type executorCallbacks struct{}
+
+func (executorCallbacks) run(*executorCallbacks) {}
+
 func Test_Executor_NewExecutor(t *testing.T) {
 	executor := flam.NewExecutor()
 	assert.NotNil(t, executor)
@@ -41,7 +45,27 @@ func Test_Executor_Run(t *testing.T) {
 		container := dig.New()
 		executor := flam.NewExecutor().Queue(callback)
 
-		assert.Error(t, executor.Run(container))
+		e := executor.Run(container)
+		assert.ErrorIs(t, e, flam.ErrExecutionFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		require.Equal(t, 1, multiErr.Len())
+		assert.Equal(t, "tests.Test_Executor_Run", multiErr.Entries()[0].Origin)
+	})
+
+	t.Run("should report a method value callback without the method value suffix", func(t *testing.T) {
+		callback := executorCallbacks{}.run
+
+		executor := flam.NewExecutor().Queue(callback)
+
+		e := executor.Run(dig.New())
+		assert.ErrorIs(t, e, flam.ErrExecutionFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		require.Equal(t, 1, multiErr.Len())
+		assert.Equal(t, "tests.executorCallbacks.run", multiErr.Entries()[0].Origin)
 	})
 
 	t.Run("should successfully execute a callback", func(t *testing.T) {
@@ -615,6 +615,38 @@ func Test_Factory_Generate(t *testing.T) {
 		assert.NotNil(t, got)
 		assert.NoError(t, e)
 	})
+	t.Run("should publish the generated resource event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{
+			"my_resource": flam.Bag{}})
+
+		resourceMock := &testResource{}
+
+		creatorMock := mocks.NewMockFactoryResourceCreator[flam.FactoryResource](ctrl)
+		creatorMock.EXPECT().Accept(flam.Bag{"id": "my_resource"}).Return(true)
+		creatorMock.EXPECT().Create(flam.Bag{"id": "my_resource"}).Return(resourceMock, nil)
+		creators := []flam.FactoryResourceCreator[flam.FactoryResource]{creatorMock}
+
+		var events [][]any
+		pubSub := flam.NewPubSub[string, string]()
+		require.NoError(t, pubSub.Subscribe("test", flam.EventFactoryResourceGenerated, func(_ string, data ...any) error {
+			events = append(events, data)
+			return nil
+		}))
+
+		factory, e := flam.NewFactory(creators, factoryConfigMock, nil, "path", pubSub)
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		got, e := factory.Generate("my_resource")
+		assert.Same(t, resourceMock, got)
+		assert.NoError(t, e)
+
+		assert.Equal(t, [][]any{{"FactoryResource", "my_resource"}}, events)
+	})
 }
 
 func Test_Factory_GenerateAll(t *testing.T) {
@@ -689,6 +721,108 @@ func Test_Factory_GenerateAll(t *testing.T) {
 	})
 }
 
+func Test_Factory_Warmup(t *testing.T) {
+	warmup := func(factory flam.Factory[flam.FactoryResource], all bool) error {
+		return factory.(interface{ Warmup(all bool) error }).Warmup(all)
+	}
+
+	t.Run("should only generate the entries flagged to boot", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{
+			"my_resource_1": flam.Bag{"boot": true},
+			"my_resource_2": flam.Bag{}}).AnyTimes()
+
+		creatorMock := mocks.NewMockFactoryResourceCreator[flam.FactoryResource](ctrl)
+		creatorMock.EXPECT().Accept(flam.Bag{"id": "my_resource_1", "boot": true}).Return(true)
+		creatorMock.EXPECT().Create(flam.Bag{"id": "my_resource_1", "boot": true}).Return(&testResource{}, nil)
+		creators := []flam.FactoryResourceCreator[flam.FactoryResource]{creatorMock}
+
+		factory, e := flam.NewFactory(creators, factoryConfigMock, nil, "path")
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		assert.NoError(t, warmup(factory, false))
+		assert.Equal(t, []string{"my_resource_1"}, factory.Stored())
+	})
+
+	t.Run("should generate all config entries if requested", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{
+			"my_resource_1": flam.Bag{},
+			"my_resource_2": flam.Bag{}}).AnyTimes()
+
+		creatorMock := mocks.NewMockFactoryResourceCreator[flam.FactoryResource](ctrl)
+		creatorMock.EXPECT().Accept(gomock.Any()).Return(true).Times(2)
+		creatorMock.EXPECT().Create(gomock.Any()).Return(&testResource{}, nil).Times(2)
+		creators := []flam.FactoryResourceCreator[flam.FactoryResource]{creatorMock}
+
+		factory, e := flam.NewFactory(creators, factoryConfigMock, nil, "path")
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		assert.NoError(t, warmup(factory, true))
+		assert.Equal(t, []string{"my_resource_1", "my_resource_2"}, factory.Stored())
+	})
+
+	t.Run("should not re-generate already stored resources", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{
+			"my_resource": flam.Bag{}}).AnyTimes()
+
+		creatorMock := mocks.NewMockFactoryResourceCreator[flam.FactoryResource](ctrl)
+		creatorMock.EXPECT().Accept(flam.Bag{"id": "my_resource"}).Return(true)
+		creatorMock.EXPECT().Create(flam.Bag{"id": "my_resource"}).Return(&testResource{}, nil)
+		creators := []flam.FactoryResourceCreator[flam.FactoryResource]{creatorMock}
+
+		factory, e := flam.NewFactory(creators, factoryConfigMock, nil, "path")
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		_, e = factory.Generate("my_resource")
+		require.NoError(t, e)
+
+		assert.NoError(t, warmup(factory, true))
+	})
+
+	t.Run("should collect all the generation errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{
+			"my_resource_1": flam.Bag{},
+			"my_resource_2": flam.Bag{}}).AnyTimes()
+
+		expectedErr := errors.New("generation failed")
+		creatorMock := mocks.NewMockFactoryResourceCreator[flam.FactoryResource](ctrl)
+		creatorMock.EXPECT().Accept(gomock.Any()).Return(true).Times(2)
+		creatorMock.EXPECT().Create(gomock.Any()).Return(nil, expectedErr).Times(2)
+		creators := []flam.FactoryResourceCreator[flam.FactoryResource]{creatorMock}
+
+		factory, e := flam.NewFactory(creators, factoryConfigMock, nil, "path")
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		e = warmup(factory, true)
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, flam.ErrWarmupFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		assert.Equal(t, []error{expectedErr}, multiErr.Origin("path.my_resource_1"))
+		assert.Equal(t, []error{expectedErr}, multiErr.Origin("path.my_resource_2"))
+	})
+}
+
 func Test_Factory_Remove(t *testing.T) {
 	t.Run("should return unknown resource if the resource is not stored", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
@@ -743,6 +877,33 @@ func Test_Factory_Remove(t *testing.T) {
 
 		assert.False(t, factory.Has("my_resource"))
 	})
+	t.Run("should publish the removed resource event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{})
+
+		readCloserMock := mocks.NewMockReadCloser(ctrl)
+		readCloserMock.EXPECT().Close().Return(nil)
+
+		var events [][]any
+		pubSub := flam.NewPubSub[string, string]()
+		require.NoError(t, pubSub.Subscribe("test", flam.EventFactoryResourceRemoved, func(_ string, data ...any) error {
+			events = append(events, data)
+			return nil
+		}))
+
+		factory, e := flam.NewFactory[flam.FactoryResource](nil, factoryConfigMock, nil, "path", pubSub)
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		require.NoError(t, factory.Store("my_resource", readCloserMock))
+
+		assert.NoError(t, factory.Remove("my_resource"))
+
+		assert.Equal(t, [][]any{{"FactoryResource", "my_resource"}}, events)
+	})
 }
 
 func Test_Factory_RemoveAll(t *testing.T) {
@@ -763,7 +924,13 @@ func Test_Factory_RemoveAll(t *testing.T) {
 
 		require.NoError(t, factory.Store("my_resource", readCloserMock))
 
-		assert.ErrorIs(t, factory.RemoveAll(), expectedErr)
+		e = factory.RemoveAll()
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, flam.ErrCloseFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		assert.Equal(t, []error{expectedErr}, multiErr.Origin("my_resource"))
 	})
 
 	t.Run("should correctly remove all stored resources", func(t *testing.T) {
@@ -789,4 +956,37 @@ func Test_Factory_RemoveAll(t *testing.T) {
 		assert.NoError(t, factory.RemoveAll())
 		assert.ElementsMatch(t, []string{}, factory.Stored())
 	})
+	t.Run("should publish the removed resource events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factoryConfigMock := mocks.NewMockFactoryConfig(ctrl)
+		factoryConfigMock.EXPECT().Get("path").Return(flam.Bag{}).Times(2)
+
+		firstReadCloserMock := mocks.NewMockReadCloser(ctrl)
+		firstReadCloserMock.EXPECT().Close().Return(nil)
+
+		secondReadCloserMock := mocks.NewMockReadCloser(ctrl)
+		secondReadCloserMock.EXPECT().Close().Return(nil)
+
+		var events [][]any
+		pubSub := flam.NewPubSub[string, string]()
+		require.NoError(t, pubSub.Subscribe("test", flam.EventFactoryResourceRemoved, func(_ string, data ...any) error {
+			events = append(events, data)
+			return nil
+		}))
+
+		factory, e := flam.NewFactory[flam.FactoryResource](nil, factoryConfigMock, nil, "path", pubSub)
+		require.NotNil(t, factory)
+		require.NoError(t, e)
+
+		require.NoError(t, factory.Store("my_resource_2", secondReadCloserMock))
+		require.NoError(t, factory.Store("my_resource_1", firstReadCloserMock))
+
+		assert.NoError(t, factory.RemoveAll())
+
+		assert.Equal(t, [][]any{
+			{"FactoryResource", "my_resource_1"},
+			{"FactoryResource", "my_resource_2"}}, events)
+	})
 }
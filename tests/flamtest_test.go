@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/flamtest"
+)
+
+func Test_Flamtest_New(t *testing.T) {
+	t.Run("should boot the in-memory resources", func(t *testing.T) {
+		harness := flamtest.New(t).MustBoot()
+
+		harness.Invoke(func(
+			diskFactory flam.DiskFactory,
+			databaseConnectionFactory flam.DatabaseConnectionFactory,
+			redisConnectionFactory flam.RedisConnectionFactory,
+		) {
+			disk, e := diskFactory.Get(flamtest.DiskId)
+			require.NoError(t, e)
+			require.NoError(t, afero.WriteFile(disk, "file.txt", []byte("content"), 0o644))
+
+			connection, e := databaseConnectionFactory.Get(flamtest.DatabaseConnectionId)
+			require.NoError(t, e)
+			require.NoError(t, connection.Exec("CREATE TABLE items (id INTEGER)").Error)
+
+			redis, e := redisConnectionFactory.Get(flamtest.RedisConnectionId)
+			require.NoError(t, e)
+			require.NoError(t, redis.Set(context.Background(), "key", "value", 0).Err())
+			assert.Equal(t, "value", redis.Get(context.Background(), "key").Val())
+		})
+	})
+
+	t.Run("should isolate the in-memory database of each harness", func(t *testing.T) {
+		create := func() {
+			flamtest.New(t).MustBoot().Invoke(func(factory flam.DatabaseConnectionFactory) {
+				connection, e := factory.Get(flamtest.DatabaseConnectionId)
+				require.NoError(t, e)
+				assert.NoError(t, connection.Exec("CREATE TABLE items (id INTEGER)").Error)
+			})
+		}
+
+		create()
+		create()
+	})
+
+	t.Run("should apply the given config overrides", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set("app.name", "my_app")
+
+		harness := flamtest.New(t, config).
+			Set("app.version", "1.0.0").
+			MustBoot()
+
+		harness.Invoke(func(config flam.Config) {
+			assert.Equal(t, "my_app", config.String("app.name"))
+			assert.Equal(t, "1.0.0", config.String("app.version"))
+			assert.True(t, config.Bool(flam.PathRedisMiniBoot))
+		})
+
+		harness.Set("app.version", "2.0.0")
+		harness.Invoke(func(config flam.Config) {
+			assert.Equal(t, "2.0.0", config.String("app.version"))
+		})
+	})
+}
+
+type fatalRecorder struct {
+	testing.TB
+
+	message string
+}
+
+func (recorder *fatalRecorder) Helper() {}
+
+func (recorder *fatalRecorder) Fatalf(format string, args ...any) {
+	recorder.message = fmt.Sprintf(format, args...)
+}
+
+func Test_Flamtest_Set(t *testing.T) {
+	t.Run("should reject the subsystem flags", func(t *testing.T) {
+		recorder := &fatalRecorder{TB: t}
+		harness := flamtest.New(recorder)
+
+		harness.Set(flam.PathSubsystemRedis, false)
+
+		assert.Contains(t, recorder.message, flam.PathSubsystemRedis)
+		assert.NoError(t, harness.Container().Invoke(func(flam.RedisConnectionFactory) {}))
+	})
+}
+
+func Test_Flamtest_Logs(t *testing.T) {
+	t.Run("should capture the logged entries", func(t *testing.T) {
+		harness := flamtest.New(t).MustBoot()
+
+		harness.Invoke(func(logger flam.Logger) {
+			logger.SignalInfo("my_channel", "signal message", flam.Bag{"key": "value"})
+			logger.BroadcastError("broadcast message")
+		})
+
+		logs := harness.Logs()
+		entries := logs.Entries()
+		require.Len(t, entries, 2)
+		assert.Equal(t, flam.LogInfo, entries[0].Level)
+		assert.Equal(t, "my_channel", entries[0].Channel)
+		assert.Equal(t, "signal message", entries[0].Message)
+		assert.Equal(t, flam.Bag{"key": "value"}, entries[0].Context)
+		assert.True(t, logs.Contains(flam.LogError, "broadcast"))
+		assert.Len(t, logs.Filter(flam.LogInfo, "my_channel"), 1)
+		assert.Empty(t, logs.Filter(flam.LogDebug, ""))
+
+		logs.Reset()
+		assert.Empty(t, logs.Entries())
+	})
+
+	t.Run("should discard entries above the stream level", func(t *testing.T) {
+		harness := flamtest.New(t).MustBoot()
+		require.NoError(t, harness.Logs().SetLevel(flam.LogError))
+
+		harness.Invoke(func(logger flam.Logger) {
+			logger.BroadcastDebug("debug message")
+			logger.BroadcastError("error message")
+		})
+
+		assert.Len(t, harness.Logs().Entries(), 1)
+	})
+
+	t.Run("should keep capturing the logged entries after a restart", func(t *testing.T) {
+		harness := flamtest.New(t).MustBoot()
+		require.NoError(t, harness.Restart())
+
+		harness.Invoke(func(logger flam.Logger) {
+			logger.BroadcastError("error message")
+		})
+
+		assert.True(t, harness.Logs().Contains(flam.LogError, "error message"))
+	})
+}
+
+func Test_Flamtest_AddProcess(t *testing.T) {
+	t.Run("should run the fake process in the kennel", func(t *testing.T) {
+		process := flamtest.NewProcess("my_process")
+		harness := flamtest.New(t).
+			Set(flam.PathKennelRun, true).
+			AddProcess(process).
+			MustBoot()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, harness.RunContext(ctx))
+		assert.Equal(t, 1, process.Runs())
+		assert.False(t, process.IsRunning())
+	})
+
+	t.Run("should return the fake process run error", func(t *testing.T) {
+		expectedErr := errors.New("run error")
+		process := flamtest.NewProcess("my_process", func(context.Context) error {
+			return expectedErr
+		})
+
+		assert.ErrorIs(t, process.Run(), expectedErr)
+		assert.Equal(t, "my_process", process.Id())
+	})
+}
+
+func Test_Flamtest_AddMigration(t *testing.T) {
+	t.Run("should execute the fake migrations", func(t *testing.T) {
+		migration := flamtest.NewMigration("1.0.0", "create items").
+			OnUp(func(connection flam.DatabaseConnection) error {
+				return connection.Exec("CREATE TABLE items (id INTEGER)").Error
+			}).
+			OnDown(func(connection flam.DatabaseConnection) error {
+				return connection.Exec("DROP TABLE items").Error
+			})
+
+		harness := flamtest.New(t).
+			AddMigration(migration).
+			MustBoot()
+
+		harness.Invoke(func(factory flam.MigratorFactory) {
+			migrator, e := factory.Get(flamtest.MigratorId)
+			require.NoError(t, e)
+
+			require.NoError(t, migrator.UpAll())
+			require.NoError(t, migrator.DownAll())
+		})
+
+		assert.Equal(t, 1, migration.Ups())
+		assert.Equal(t, 1, migration.Downs())
+	})
+}
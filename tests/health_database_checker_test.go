@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_DatabaseHealthChecker(t *testing.T) {
+	check := func(t *testing.T, app flam.Application) flam.HealthCheckReport {
+		var result flam.HealthCheckReport
+		require.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			for _, report := range health.Check(context.Background()).Checks {
+				if report.Id == flam.HealthCheckerDatabase {
+					result = report
+				}
+			}
+		}))
+
+		return result
+	}
+
+	t.Run("should report up if no connection was generated", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusUp, report.Status)
+		assert.NoError(t, report.Error)
+	})
+
+	t.Run("should report up if every connection responds", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathDatabaseConfigs, flam.Bag{
+			"my_config": flam.Bag{
+				"driver": flam.DatabaseConfigDriverDefault}})
+		_ = config.Set(flam.PathDatabaseDialects, flam.Bag{
+			"my_dialect": flam.Bag{
+				"driver": flam.DatabaseDialectDriverSqlite}})
+		_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"dialect_id": "my_dialect",
+				"config_id":  "my_config"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			_, e := factory.Get("my_connection")
+			require.NoError(t, e)
+		}))
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusUp, report.Status)
+		assert.NoError(t, report.Error)
+	})
+
+	t.Run("should report down if any connection fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("db error")
+		connectionMock := mocks.NewMockDatabaseConnection(ctrl)
+		connectionMock.EXPECT().DB().Return(nil, expectedErr)
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			require.NoError(t, factory.Store("my_connection", connectionMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusDown, report.Status)
+		assert.ErrorIs(t, report.Error, expectedErr)
+		assert.ErrorContains(t, report.Error, "DatabaseConnection[my_connection]")
+	})
+}
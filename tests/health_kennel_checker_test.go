@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_KennelHealthChecker(t *testing.T) {
+	check := func(t *testing.T, app flam.Application) flam.HealthCheckReport {
+		var result flam.HealthCheckReport
+		require.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			for _, report := range health.Check(context.Background()).Checks {
+				if report.Id == flam.HealthCheckerKennel {
+					result = report
+				}
+			}
+		}))
+
+		return result
+	}
+
+	t.Run("should report up if the kennel is not flagged to run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusUp, report.Status)
+		assert.NoError(t, report.Error)
+	})
+
+	t.Run("should report up if every active process is running", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true},
+			"inactive_process_id": flam.Bag{
+				"active": false}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().IsRunning().Return(true)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		inactiveProcessMock := mocks.NewMockProcess(ctrl)
+		inactiveProcessMock.EXPECT().Id().Return("inactive_process_id")
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return inactiveProcessMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusUp, report.Status)
+		assert.NoError(t, report.Error)
+	})
+
+	t.Run("should report down if any active process is not running", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().IsRunning().Return(false)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusDown, report.Status)
+		assert.ErrorIs(t, report.Error, flam.ErrProcessNotRunning)
+		assert.ErrorContains(t, report.Error, "Process[process_id]")
+	})
+}
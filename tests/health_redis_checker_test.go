@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_RedisHealthChecker(t *testing.T) {
+	check := func(t *testing.T, app flam.Application) flam.HealthCheckReport {
+		var result flam.HealthCheckReport
+		require.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			for _, report := range health.Check(context.Background()).Checks {
+				if report.Id == flam.HealthCheckerRedis {
+					result = report
+				}
+			}
+		}))
+
+		return result
+	}
+
+	t.Run("should report up if every connection responds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		connectionMock := mocks.NewMockRedisConnection(ctrl)
+		connectionMock.EXPECT().Ping(gomock.Any()).Return(redis.NewStatusResult("PONG", nil))
+		connectionMock.EXPECT().Close().Return(nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.RedisConnectionFactory) {
+			require.NoError(t, factory.Store("my_connection", connectionMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusUp, report.Status)
+		assert.NoError(t, report.Error)
+	})
+
+	t.Run("should report down if any connection fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("ping error")
+		connectionMock := mocks.NewMockRedisConnection(ctrl)
+		connectionMock.EXPECT().Ping(gomock.Any()).Return(redis.NewStatusResult("", expectedErr))
+		connectionMock.EXPECT().Close().Return(nil)
+		require.NoError(t, app.Container().Invoke(func(factory flam.RedisConnectionFactory) {
+			require.NoError(t, factory.Store("my_connection", connectionMock))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		report := check(t, app)
+		assert.Equal(t, flam.HealthStatusDown, report.Status)
+		assert.ErrorIs(t, report.Error, expectedErr)
+		assert.ErrorContains(t, report.Error, "RedisConnection[my_connection]")
+	})
+}
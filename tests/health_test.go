@@ -0,0 +1,171 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+
+	"github.com/cjdias/flam-in-go"
+	"github.com/cjdias/flam-in-go/tests/mocks"
+)
+
+func Test_Health_Checkers(t *testing.T) {
+	t.Run("should list the built-in checkers", func(t *testing.T) {
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			assert.Equal(t, []string{
+				flam.HealthCheckerDatabase,
+				flam.HealthCheckerKennel,
+				flam.HealthCheckerRedis}, health.Checkers())
+		}))
+	})
+
+	t.Run("should list the registered checkers sorted by id", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			assert.Equal(t, []string{
+				"custom",
+				flam.HealthCheckerDatabase,
+				flam.HealthCheckerKennel,
+				flam.HealthCheckerRedis}, health.Checkers())
+		}))
+	})
+}
+
+func Test_Health_Check(t *testing.T) {
+	t.Run("should report up if every check succeeds", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		checkerMock.EXPECT().Check(gomock.Any()).Return(nil)
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			report := health.Check(context.Background())
+			assert.Equal(t, flam.HealthStatusUp, report.Status)
+			require.Len(t, report.Checks, 4)
+			assert.Equal(t, "custom", report.Checks[0].Id)
+			assert.Equal(t, flam.HealthStatusUp, report.Checks[0].Status)
+			assert.NoError(t, report.Checks[0].Error)
+		}))
+	})
+
+	t.Run("should report down if any check fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		expectedErr := errors.New("check error")
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		checkerMock.EXPECT().Check(gomock.Any()).Return(expectedErr)
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			report := health.Check(context.Background())
+			assert.Equal(t, flam.HealthStatusDown, report.Status)
+			require.Len(t, report.Checks, 4)
+			assert.Equal(t, flam.HealthStatusDown, report.Checks[0].Status)
+			assert.ErrorIs(t, report.Checks[0].Error, expectedErr)
+			assert.Equal(t, flam.HealthStatusUp, report.Checks[1].Status)
+		}))
+	})
+
+	t.Run("should report down a check exceeding the default timeout", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathHealthTimeout, 10*time.Millisecond)
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		checkerMock.EXPECT().Check(gomock.Any()).DoAndReturn(func(context.Context) error {
+			time.Sleep(100 * time.Millisecond)
+			return nil
+		})
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			report := health.Check(context.Background())
+			assert.Equal(t, flam.HealthStatusDown, report.Status)
+			assert.ErrorIs(t, report.Checks[0].Error, flam.ErrHealthCheckTimeout)
+		}))
+	})
+
+	t.Run("should use the per check timeout if defined", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathHealthChecks, flam.Bag{
+			"custom": flam.Bag{
+				"timeout": 10 * time.Millisecond}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		checkerMock := mocks.NewMockHealthChecker(ctrl)
+		checkerMock.EXPECT().Id().Return("custom").AnyTimes()
+		checkerMock.EXPECT().Check(gomock.Any()).DoAndReturn(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		require.NoError(t, app.Container().Provide(func() flam.HealthChecker {
+			return checkerMock
+		}, dig.Group(flam.HealthCheckerGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			report := health.Check(context.Background())
+			assert.Equal(t, flam.HealthStatusDown, report.Status)
+			assert.Error(t, report.Checks[0].Error)
+		}))
+	})
+}
@@ -1,6 +1,7 @@
 package tests
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"testing"
@@ -148,6 +149,38 @@ func Test_Kennel_IsActive(t *testing.T) {
 	})
 }
 
+func Test_Kennel_IsRunning(t *testing.T) {
+	t.Run("should correctly return the process running state", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		app := flam.NewApplication()
+		defer func() { _ = app.Close() }()
+
+		processMock1 := mocks.NewMockProcess(ctrl)
+		processMock1.EXPECT().Id().Return("process_1_id")
+		processMock1.EXPECT().IsRunning().Return(true)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock1
+		}, dig.Group(flam.ProcessGroup)))
+
+		processMock2 := mocks.NewMockProcess(ctrl)
+		processMock2.EXPECT().Id().Return("process_2_id")
+		processMock2.EXPECT().IsRunning().Return(false)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock2
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(kennel flam.Kennel) {
+			assert.True(t, kennel.IsRunning("process_1_id"))
+			assert.False(t, kennel.IsRunning("process_2_id"))
+			assert.False(t, kennel.IsRunning("process_3_id"))
+		}))
+	})
+}
+
 func Test_Kennel_Activate(t *testing.T) {
 	t.Run("should return error if the process was not found", func(t *testing.T) {
 		app := flam.NewApplication()
@@ -471,6 +504,55 @@ func Test_Kennel_Run(t *testing.T) {
 		assert.NoError(t, app.Run())
 	})
 
+	t.Run("should publish the process lifecycle events", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		run := 0
+		expectedErr := errors.New("process error")
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().Run().DoAndReturn(func() error {
+			run++
+			if run == 1 {
+				panic(expectedErr)
+			}
+
+			return nil
+		}).Times(2)
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		var events [][]any
+		require.NoError(t, app.Container().Invoke(func(pubSub flam.PubSub[string, string]) {
+			for _, channel := range []string{flam.EventProcessStarted, flam.EventProcessCrashed, flam.EventProcessStopped} {
+				require.NoError(t, pubSub.Subscribe("test", channel, func(channel string, data ...any) error {
+					events = append(events, append([]any{channel}, data...))
+					return nil
+				}))
+			}
+		}))
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Run())
+
+		assert.Equal(t, [][]any{
+			{flam.EventProcessStarted, "process_id"},
+			{flam.EventProcessCrashed, "process_id", expectedErr},
+			{flam.EventProcessStopped, "process_id", nil}}, events)
+	})
+
 	t.Run("should correctly log the process recover", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -561,6 +643,42 @@ func Test_Kennel_Run(t *testing.T) {
 
 		assert.NoError(t, app.Run())
 	})
+	t.Run("should terminate the running processes when the context is cancelled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().Run().DoAndReturn(func() error {
+			wg.Wait()
+			return nil
+		})
+		processMock.EXPECT().Terminate().DoAndReturn(func() error {
+			wg.Done()
+			return nil
+		})
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		require.NoError(t, app.Boot())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.NoError(t, app.RunContext(ctx))
+	})
 }
 
 func Test_Kennel_Close(t *testing.T) {
@@ -604,4 +722,60 @@ func Test_Kennel_Close(t *testing.T) {
 
 		assert.NoError(t, app.Run())
 	})
+
+	t.Run("should stop waiting on a hanging process termination when the close context is done", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathKennelRun, true)
+		_ = config.Set(flam.PathProcesses, flam.Bag{
+			"process_id": flam.Bag{
+				"active": true}})
+
+		app := flam.NewApplication(config)
+
+		release := make(chan struct{})
+		running := make(chan struct{})
+		processMock := mocks.NewMockProcess(ctrl)
+		processMock.EXPECT().Id().Return("process_id")
+		processMock.EXPECT().Run().DoAndReturn(func() error {
+			close(running)
+			<-release
+			return nil
+		})
+		processMock.EXPECT().Terminate().DoAndReturn(func() error {
+			<-release
+			return nil
+		})
+		require.NoError(t, app.Container().Provide(func() flam.Process {
+			return processMock
+		}, dig.Group(flam.ProcessGroup)))
+
+		var closed sync.WaitGroup
+		closed.Add(1)
+		configSourceMock := mocks.NewMockConfigSource(ctrl)
+		configSourceMock.EXPECT().Get("", flam.Bag{}).Return(flam.Bag{}).AnyTimes()
+		configSourceMock.EXPECT().GetPriority().Return(0).AnyTimes()
+		configSourceMock.EXPECT().Close().DoAndReturn(func() error {
+			closed.Done()
+			return nil
+		})
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Container().Invoke(func(factory flam.ConfigSourceFactory) {
+			require.NoError(t, factory.Store("my_source", configSourceMock))
+		}))
+
+		go func() { _ = app.Run() }()
+		<-running
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, app.CloseContext(ctx), flam.ErrProviderCloseTimeout)
+
+		closed.Wait()
+		close(release)
+	})
 }
@@ -1344,6 +1344,87 @@ func Test_DefaultMigrator_Up(t *testing.T) {
 		}))
 	})
 
+	t.Run("should publish the applied migration event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathMigrators, flam.Bag{
+			"my_migrator": flam.Bag{
+				"driver":        flam.MigratorDriverDefault,
+				"connection_id": "my_connection",
+				"group":         "group1"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		db, dbMock := SetupDatabase()
+		dbMock.
+			ExpectQuery("SELECT \\* FROM `__migrations`").
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "version", "description", "created_at", "updated_at"}).
+				AddRow(1, "1.0.0", "1.0.0-description", time.Now(), time.Now()))
+		dbMock.ExpectBegin()
+		dbMock.
+			ExpectExec("INSERT INTO `__migrations`").
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		dbMock.ExpectCommit()
+
+		databaseConnectionMock := mocks.NewMockDatabaseConnection(ctrl)
+		databaseConnectionMock.EXPECT().AutoMigrate(gomock.Any()).Return(nil)
+		databaseConnectionMock.EXPECT().Order("created_at desc").Return(db)
+		databaseConnectionMock.
+			EXPECT().
+			Transaction(gomock.Any()).
+			DoAndReturn(func(
+				callback func(tx *gorm.DB) error,
+				opts ...*sql.TxOptions) error {
+				return callback(db)
+			})
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			require.NoError(t, factory.Store("my_connection", databaseConnectionMock))
+		}))
+
+		migration1Mock := mocks.NewMockMigration(ctrl)
+		migration1Mock.EXPECT().Version().Return("2.0.0").AnyTimes()
+		migration1Mock.EXPECT().Description().Return("2.0.0-description").AnyTimes()
+		migration1Mock.EXPECT().Group().Return("group1").AnyTimes()
+		migration1Mock.EXPECT().Up(gomock.Any()).Return(nil)
+		require.NoError(t, app.Container().Provide(func() flam.Migration {
+			return migration1Mock
+		}, dig.Group(flam.MigrationGroup)))
+
+		migration2Mock := mocks.NewMockMigration(ctrl)
+		migration2Mock.EXPECT().Version().Return("1.0.0").AnyTimes()
+		migration2Mock.EXPECT().Description().Return("1.0.0-description").AnyTimes()
+		migration2Mock.EXPECT().Group().Return("group1").AnyTimes()
+		require.NoError(t, app.Container().Provide(func() flam.Migration {
+			return migration2Mock
+		}, dig.Group(flam.MigrationGroup)))
+
+		var events [][]any
+		require.NoError(t, app.Container().Invoke(func(pubSub flam.PubSub[string, string]) {
+			require.NoError(t, pubSub.Subscribe("test", flam.EventMigrationApplied, func(_ string, data ...any) error {
+				events = append(events, data)
+				return nil
+			}))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			migrator, e := factory.Get("my_migrator")
+			require.NotNil(t, migrator)
+			require.NoError(t, e)
+
+			require.NoError(t, migrator.Up())
+		}))
+
+		require.Equal(t, [][]any{{"my_migrator", flam.MigrationInfo{
+			Version:     "2.0.0",
+			Description: "2.0.0-description"}}}, events)
+	})
+
 	t.Run("should return no error on success migration with logging", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -2377,6 +2458,87 @@ func Test_DefaultMigrator_Down(t *testing.T) {
 		}))
 	})
 
+	t.Run("should publish the reverted migration event", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		config := flam.Bag{}
+		_ = config.Set(flam.PathMigrators, flam.Bag{
+			"my_migrator": flam.Bag{
+				"driver":        flam.MigratorDriverDefault,
+				"connection_id": "my_connection",
+				"group":         "group1"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		db, dbMock := SetupDatabase()
+		dbMock.
+			ExpectQuery("SELECT \\* FROM `__migrations`").
+			WillReturnRows(sqlmock.
+				NewRows([]string{"id", "version", "description", "created_at", "updated_at"}).
+				AddRow(1, "1.0.0", "1.0.0-description", time.Now(), time.Now()))
+		dbMock.ExpectBegin()
+		dbMock.
+			ExpectExec("DELETE FROM `__migrations`").
+			WillReturnResult(sqlmock.NewResult(2, 1))
+		dbMock.ExpectCommit()
+
+		databaseConnectionMock := mocks.NewMockDatabaseConnection(ctrl)
+		databaseConnectionMock.EXPECT().AutoMigrate(gomock.Any()).Return(nil)
+		databaseConnectionMock.EXPECT().Order("created_at desc").Return(db)
+		databaseConnectionMock.
+			EXPECT().
+			Transaction(gomock.Any()).
+			DoAndReturn(func(
+				callback func(tx *gorm.DB) error,
+				opts ...*sql.TxOptions) error {
+				return callback(db)
+			})
+		require.NoError(t, app.Container().Invoke(func(factory flam.DatabaseConnectionFactory) {
+			require.NoError(t, factory.Store("my_connection", databaseConnectionMock))
+		}))
+
+		migration1Mock := mocks.NewMockMigration(ctrl)
+		migration1Mock.EXPECT().Version().Return("2.0.0").AnyTimes()
+		migration1Mock.EXPECT().Description().Return("2.0.0-description").AnyTimes()
+		migration1Mock.EXPECT().Group().Return("group1").AnyTimes()
+		require.NoError(t, app.Container().Provide(func() flam.Migration {
+			return migration1Mock
+		}, dig.Group(flam.MigrationGroup)))
+
+		migration2Mock := mocks.NewMockMigration(ctrl)
+		migration2Mock.EXPECT().Version().Return("1.0.0").AnyTimes()
+		migration2Mock.EXPECT().Description().Return("1.0.0-description").AnyTimes()
+		migration2Mock.EXPECT().Group().Return("group1").AnyTimes()
+		migration2Mock.EXPECT().Down(gomock.Any()).Return(nil)
+		require.NoError(t, app.Container().Provide(func() flam.Migration {
+			return migration2Mock
+		}, dig.Group(flam.MigrationGroup)))
+
+		var events [][]any
+		require.NoError(t, app.Container().Invoke(func(pubSub flam.PubSub[string, string]) {
+			require.NoError(t, pubSub.Subscribe("test", flam.EventMigrationReverted, func(_ string, data ...any) error {
+				events = append(events, data)
+				return nil
+			}))
+		}))
+
+		require.NoError(t, app.Boot())
+
+		require.NoError(t, app.Container().Invoke(func(factory flam.MigratorFactory) {
+			migrator, e := factory.Get("my_migrator")
+			require.NotNil(t, migrator)
+			require.NoError(t, e)
+
+			require.NoError(t, migrator.Down())
+		}))
+
+		require.Equal(t, [][]any{{"my_migrator", flam.MigrationInfo{
+			Version:     "1.0.0",
+			Description: "1.0.0-description"}}}, events)
+	})
+
 	t.Run("should return no error on success migration with logging", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -36,18 +36,18 @@ func (m *MockConfigRestClientGenerator) EXPECT() *MockConfigRestClientGeneratorM
 }
 
 // Create mocks base method.
-func (m *MockConfigRestClientGenerator) Create() (flam.ConfigRestClient, error) {
+func (m *MockConfigRestClientGenerator) Create(config flam.Bag) (flam.ConfigRestClient, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create")
+	ret := m.ctrl.Call(m, "Create", config)
 	ret0, _ := ret[0].(flam.ConfigRestClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockConfigRestClientGeneratorMockRecorder) Create() *gomock.Call {
+func (mr *MockConfigRestClientGeneratorMockRecorder) Create(config interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConfigRestClientGenerator)(nil).Create))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockConfigRestClientGenerator)(nil).Create), config)
 }
 
 // MockConfigRestClient is a mock of ConfigRestClient interface.
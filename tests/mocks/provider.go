@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	flam "github.com/cjdias/flam-in-go"
@@ -128,6 +129,150 @@ func (mr *MockConfigurableProviderMockRecorder) Register(container interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockConfigurableProvider)(nil).Register), container)
 }
 
+// MockConfigSchemaProvider is a mock of ConfigSchemaProvider interface.
+type MockConfigSchemaProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfigSchemaProviderMockRecorder
+}
+
+// MockConfigSchemaProviderMockRecorder is the mock recorder for MockConfigSchemaProvider.
+type MockConfigSchemaProviderMockRecorder struct {
+	mock *MockConfigSchemaProvider
+}
+
+// NewMockConfigSchemaProvider creates a new mock instance.
+func NewMockConfigSchemaProvider(ctrl *gomock.Controller) *MockConfigSchemaProvider {
+	mock := &MockConfigSchemaProvider{ctrl: ctrl}
+	mock.recorder = &MockConfigSchemaProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfigSchemaProvider) EXPECT() *MockConfigSchemaProviderMockRecorder {
+	return m.recorder
+}
+
+// Config mocks base method.
+func (m *MockConfigSchemaProvider) Config(config *flam.Bag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Config", config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Config indicates an expected call of Config.
+func (mr *MockConfigSchemaProviderMockRecorder) Config(config interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Config", reflect.TypeOf((*MockConfigSchemaProvider)(nil).Config), config)
+}
+
+// ConfigSchema mocks base method.
+func (m *MockConfigSchemaProvider) ConfigSchema() flam.ConfigSchema {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigSchema")
+	ret0, _ := ret[0].(flam.ConfigSchema)
+	return ret0
+}
+
+// ConfigSchema indicates an expected call of ConfigSchema.
+func (mr *MockConfigSchemaProviderMockRecorder) ConfigSchema() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigSchema", reflect.TypeOf((*MockConfigSchemaProvider)(nil).ConfigSchema))
+}
+
+// Id mocks base method.
+func (m *MockConfigSchemaProvider) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockConfigSchemaProviderMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockConfigSchemaProvider)(nil).Id))
+}
+
+// Register mocks base method.
+func (m *MockConfigSchemaProvider) Register(container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockConfigSchemaProviderMockRecorder) Register(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockConfigSchemaProvider)(nil).Register), container)
+}
+
+// MockDependentProvider is a mock of DependentProvider interface.
+type MockDependentProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependentProviderMockRecorder
+}
+
+// MockDependentProviderMockRecorder is the mock recorder for MockDependentProvider.
+type MockDependentProviderMockRecorder struct {
+	mock *MockDependentProvider
+}
+
+// NewMockDependentProvider creates a new mock instance.
+func NewMockDependentProvider(ctrl *gomock.Controller) *MockDependentProvider {
+	mock := &MockDependentProvider{ctrl: ctrl}
+	mock.recorder = &MockDependentProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependentProvider) EXPECT() *MockDependentProviderMockRecorder {
+	return m.recorder
+}
+
+// Dependencies mocks base method.
+func (m *MockDependentProvider) Dependencies() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dependencies")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// Dependencies indicates an expected call of Dependencies.
+func (mr *MockDependentProviderMockRecorder) Dependencies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dependencies", reflect.TypeOf((*MockDependentProvider)(nil).Dependencies))
+}
+
+// Id mocks base method.
+func (m *MockDependentProvider) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockDependentProviderMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockDependentProvider)(nil).Id))
+}
+
+// Register mocks base method.
+func (m *MockDependentProvider) Register(container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockDependentProviderMockRecorder) Register(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockDependentProvider)(nil).Register), container)
+}
+
 // MockBootableProvider is a mock of BootableProvider interface.
 type MockBootableProvider struct {
 	ctrl     *gomock.Controller
@@ -193,6 +338,71 @@ func (mr *MockBootableProviderMockRecorder) Register(container interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockBootableProvider)(nil).Register), container)
 }
 
+// MockContextBootableProvider is a mock of ContextBootableProvider interface.
+type MockContextBootableProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextBootableProviderMockRecorder
+}
+
+// MockContextBootableProviderMockRecorder is the mock recorder for MockContextBootableProvider.
+type MockContextBootableProviderMockRecorder struct {
+	mock *MockContextBootableProvider
+}
+
+// NewMockContextBootableProvider creates a new mock instance.
+func NewMockContextBootableProvider(ctrl *gomock.Controller) *MockContextBootableProvider {
+	mock := &MockContextBootableProvider{ctrl: ctrl}
+	mock.recorder = &MockContextBootableProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextBootableProvider) EXPECT() *MockContextBootableProviderMockRecorder {
+	return m.recorder
+}
+
+// BootContext mocks base method.
+func (m *MockContextBootableProvider) BootContext(ctx context.Context, container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootContext", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootContext indicates an expected call of BootContext.
+func (mr *MockContextBootableProviderMockRecorder) BootContext(ctx, container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootContext", reflect.TypeOf((*MockContextBootableProvider)(nil).BootContext), ctx, container)
+}
+
+// Id mocks base method.
+func (m *MockContextBootableProvider) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockContextBootableProviderMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockContextBootableProvider)(nil).Id))
+}
+
+// Register mocks base method.
+func (m *MockContextBootableProvider) Register(container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockContextBootableProviderMockRecorder) Register(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockContextBootableProvider)(nil).Register), container)
+}
+
 // MockRunnableProvider is a mock of RunnableProvider interface.
 type MockRunnableProvider struct {
 	ctrl     *gomock.Controller
@@ -258,6 +468,71 @@ func (mr *MockRunnableProviderMockRecorder) Run(container interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockRunnableProvider)(nil).Run), container)
 }
 
+// MockContextRunnableProvider is a mock of ContextRunnableProvider interface.
+type MockContextRunnableProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextRunnableProviderMockRecorder
+}
+
+// MockContextRunnableProviderMockRecorder is the mock recorder for MockContextRunnableProvider.
+type MockContextRunnableProviderMockRecorder struct {
+	mock *MockContextRunnableProvider
+}
+
+// NewMockContextRunnableProvider creates a new mock instance.
+func NewMockContextRunnableProvider(ctrl *gomock.Controller) *MockContextRunnableProvider {
+	mock := &MockContextRunnableProvider{ctrl: ctrl}
+	mock.recorder = &MockContextRunnableProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextRunnableProvider) EXPECT() *MockContextRunnableProviderMockRecorder {
+	return m.recorder
+}
+
+// Id mocks base method.
+func (m *MockContextRunnableProvider) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockContextRunnableProviderMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockContextRunnableProvider)(nil).Id))
+}
+
+// Register mocks base method.
+func (m *MockContextRunnableProvider) Register(container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockContextRunnableProviderMockRecorder) Register(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockContextRunnableProvider)(nil).Register), container)
+}
+
+// RunContext mocks base method.
+func (m *MockContextRunnableProvider) RunContext(ctx context.Context, container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunContext", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunContext indicates an expected call of RunContext.
+func (mr *MockContextRunnableProviderMockRecorder) RunContext(ctx, container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunContext", reflect.TypeOf((*MockContextRunnableProvider)(nil).RunContext), ctx, container)
+}
+
 // MockClosableProvider is a mock of ClosableProvider interface.
 type MockClosableProvider struct {
 	ctrl     *gomock.Controller
@@ -322,3 +597,68 @@ func (mr *MockClosableProviderMockRecorder) Register(container interface{}) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockClosableProvider)(nil).Register), container)
 }
+
+// MockContextClosableProvider is a mock of ContextClosableProvider interface.
+type MockContextClosableProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockContextClosableProviderMockRecorder
+}
+
+// MockContextClosableProviderMockRecorder is the mock recorder for MockContextClosableProvider.
+type MockContextClosableProviderMockRecorder struct {
+	mock *MockContextClosableProvider
+}
+
+// NewMockContextClosableProvider creates a new mock instance.
+func NewMockContextClosableProvider(ctrl *gomock.Controller) *MockContextClosableProvider {
+	mock := &MockContextClosableProvider{ctrl: ctrl}
+	mock.recorder = &MockContextClosableProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContextClosableProvider) EXPECT() *MockContextClosableProviderMockRecorder {
+	return m.recorder
+}
+
+// CloseContext mocks base method.
+func (m *MockContextClosableProvider) CloseContext(ctx context.Context, container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseContext", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseContext indicates an expected call of CloseContext.
+func (mr *MockContextClosableProviderMockRecorder) CloseContext(ctx, container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseContext", reflect.TypeOf((*MockContextClosableProvider)(nil).CloseContext), ctx, container)
+}
+
+// Id mocks base method.
+func (m *MockContextClosableProvider) Id() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Id")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Id indicates an expected call of Id.
+func (mr *MockContextClosableProviderMockRecorder) Id() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Id", reflect.TypeOf((*MockContextClosableProvider)(nil).Id))
+}
+
+// Register mocks base method.
+func (m *MockContextClosableProvider) Register(container *dig.Container) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockContextClosableProviderMockRecorder) Register(container interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockContextClosableProvider)(nil).Register), container)
+}
@@ -0,0 +1,106 @@
+package tests
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_MultiError_Add(t *testing.T) {
+	t.Run("should ignore nil errors", func(t *testing.T) {
+		e := flam.NewMultiError(nil).Add("origin", nil)
+
+		assert.Equal(t, 0, e.Len())
+		assert.Empty(t, e.Entries())
+		assert.NoError(t, e.ErrorOrNil())
+	})
+
+	t.Run("should store the errors with their origin", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		err3 := errors.New("error 3")
+
+		e := flam.NewMultiError(nil).
+			Add("origin1", err1).
+			Add("origin2", err2).
+			Add("origin1", err3)
+
+		assert.Equal(t, 3, e.Len())
+		assert.Equal(t, []flam.MultiErrorEntry{
+			{Origin: "origin1", Err: err1},
+			{Origin: "origin2", Err: err2},
+			{Origin: "origin1", Err: err3}}, e.Entries())
+		assert.Equal(t, []error{err1, err3}, e.Origin("origin1"))
+		assert.Equal(t, []error{err2}, e.Origin("origin2"))
+		assert.Nil(t, e.Origin("origin3"))
+	})
+}
+
+func Test_MultiError_ErrorOrNil(t *testing.T) {
+	t.Run("should return nil on a nil reference", func(t *testing.T) {
+		var e *flam.MultiError
+
+		assert.NoError(t, e.ErrorOrNil())
+	})
+
+	t.Run("should return the multi error if storing errors", func(t *testing.T) {
+		e := flam.NewMultiError(nil).Add("origin", errors.New("error"))
+
+		assert.Same(t, e, e.ErrorOrNil())
+	})
+}
+
+func Test_MultiError_Error(t *testing.T) {
+	t.Run("should join the error messages with their origin", func(t *testing.T) {
+		e := flam.NewMultiError(nil).
+			Add("origin", errors.New("error 1")).
+			Add("", errors.New("error 2"))
+
+		assert.Equal(t, "origin: error 1\nerror 2", e.Error())
+	})
+
+	t.Run("should prefix the messages with the multi error kind", func(t *testing.T) {
+		e := flam.NewMultiError(flam.ErrCloseFailed).Add("origin", errors.New("error"))
+
+		assert.Equal(t, "close failed:\norigin: error", e.Error())
+	})
+}
+
+func Test_MultiError_Is(t *testing.T) {
+	t.Run("should match the multi error kind", func(t *testing.T) {
+		e := flam.NewMultiError(flam.ErrCloseFailed).Add("origin", errors.New("error"))
+
+		assert.ErrorIs(t, e, flam.ErrCloseFailed)
+		assert.NotErrorIs(t, e, flam.ErrPublishFailed)
+	})
+
+	t.Run("should match the stored errors", func(t *testing.T) {
+		expectedErr := errors.New("error")
+		e := flam.NewMultiError(nil).Add("origin", flam.NewErrorFrom(expectedErr, "context"))
+
+		assert.ErrorIs(t, e, expectedErr)
+	})
+
+	t.Run("should match the stored errors when joined", func(t *testing.T) {
+		expectedErr := errors.New("error")
+		e := errors.Join(errors.New("other"), flam.NewMultiError(nil).Add("origin", expectedErr))
+
+		assert.ErrorIs(t, e, expectedErr)
+	})
+}
+
+func Test_MultiError_As(t *testing.T) {
+	t.Run("should find the stored errors types", func(t *testing.T) {
+		e := flam.NewMultiError(nil).
+			Add("origin1", errors.New("error")).
+			Add("origin2", flam.NewError("flam error").SetCode(123))
+
+		var flamErr flam.Error
+		require.ErrorAs(t, e, &flamErr)
+		assert.Equal(t, 123, flamErr.GetCode())
+	})
+}
@@ -316,10 +316,11 @@ func Test_Provider_Register(t *testing.T) {
 
 		configRestClient := mocks.NewMockConfigRestClient(ctrl)
 		configRestClient.EXPECT().Do(gomock.Any()).Return(&http.Response{
+			StatusCode: http.StatusOK,
 			Body: io.NopCloser(bytes.NewReader(
 				[]byte(`{"config": {}}`)))}, nil)
 		configRestClientGenerator := mocks.NewMockConfigRestClientGenerator(ctrl)
-		configRestClientGenerator.EXPECT().Create().Return(configRestClient, nil)
+		configRestClientGenerator.EXPECT().Create(gomock.Any()).Return(configRestClient, nil)
 		require.NoError(t, app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return configRestClientGenerator
 		}))
@@ -355,10 +356,11 @@ func Test_Provider_Register(t *testing.T) {
 
 		configRestClient := mocks.NewMockConfigRestClient(ctrl)
 		configRestClient.EXPECT().Do(gomock.Any()).Return(&http.Response{
+			StatusCode: http.StatusOK,
 			Body: io.NopCloser(bytes.NewReader([]byte(
 				fmt.Sprintf(`{"config": {}, "timestamp": "%s"}`, time.Now().Format(time.RFC3339)))))}, nil)
 		configRestClientGenerator := mocks.NewMockConfigRestClientGenerator(ctrl)
-		configRestClientGenerator.EXPECT().Create().Return(configRestClient, nil)
+		configRestClientGenerator.EXPECT().Create(gomock.Any()).Return(configRestClient, nil)
 		require.NoError(t, app.Container().Decorate(func(flam.ConfigRestClientGenerator) flam.ConfigRestClientGenerator {
 			return configRestClientGenerator
 		}))
@@ -989,12 +991,19 @@ func Test_Provider_Config(t *testing.T) {
 		assert.NoError(t, app.Container().Invoke(func(config flam.Config) {
 			assert.Equal(t, config.Get(flam.PathConfigBoot), flam.DefaultConfigBoot)
 			assert.Equal(t, config.Get(flam.PathConfigObserverFrequency), flam.DefaultConfigObserverFrequency)
+			assert.Equal(t, config.Get(flam.PathConfigWatcherEnabled), flam.DefaultConfigWatcherEnabled)
+			assert.Equal(t, config.Get(flam.PathConfigWatcherDebounce), flam.DefaultConfigWatcherDebounce)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultFileParserId), flam.DefaultConfigFileParserId)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultFileDiskId), flam.DefaultConfigFileDiskId)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultRestParserId), flam.DefaultConfigRestParserId)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultRestConfigPath), flam.DefaultConfigRestConfigPath)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultRestTimestampPath), flam.DefaultConfigRestTimestampPath)
+			assert.Equal(t, config.Get(flam.PathConfigDefaultRestTimeout), flam.DefaultConfigRestTimeout)
+			assert.Equal(t, config.Get(flam.PathConfigDefaultRestRetryAttempts), flam.DefaultConfigRestRetryAttempts)
+			assert.Equal(t, config.Get(flam.PathConfigDefaultRestRetryBackoff), flam.DefaultConfigRestRetryBackoff)
+			assert.Equal(t, config.Get(flam.PathConfigDefaultDatabaseTable), flam.DefaultConfigDatabaseTable)
 			assert.Equal(t, config.Get(flam.PathConfigDefaultPriority), flam.DefaultConfigPriority)
+			assert.Equal(t, config.Get(flam.PathConfigDefaultEnvSeparator), flam.DefaultConfigEnvSeparator)
 
 			assert.Equal(t, config.Get(flam.PathLogBoot), flam.DefaultLogBoot)
 			assert.Equal(t, config.Get(flam.PathLogFlusherFrequency), flam.DefaultLogFlusherFrequency)
@@ -1039,8 +1048,113 @@ func Test_Provider_Config(t *testing.T) {
 			assert.Equal(t, config.Get(flam.PathWatchdogDefaultLoggerStartLevel), flam.DefaultWatchdogLoggerStartLevel)
 			assert.Equal(t, config.Get(flam.PathWatchdogDefaultLoggerErrorLevel), flam.DefaultWatchdogLoggerErrorLevel)
 			assert.Equal(t, config.Get(flam.PathWatchdogDefaultLoggerDoneLevel), flam.DefaultWatchdogLoggerDoneLevel)
+
+			assert.Equal(t, config.Get(flam.PathHealthTimeout), flam.DefaultHealthTimeout)
+
+			assert.Equal(t, config.Get(flam.PathAdminBoot), flam.DefaultAdminBoot)
+			assert.Equal(t, config.Get(flam.PathAdminAddress), flam.DefaultAdminAddress)
+			assert.Equal(t, config.Get(flam.PathConfigRedact), []string{"password", "secret", "token"})
+
+			assert.Equal(t, config.Get(flam.PathShutdownTimeout), flam.DefaultShutdownTimeout)
+
+			assert.Equal(t, config.Get(flam.PathSubsystemDatabase), flam.DefaultSubsystemDatabase)
+			assert.Equal(t, config.Get(flam.PathSubsystemMigrator), flam.DefaultSubsystemMigrator)
+			assert.Equal(t, config.Get(flam.PathSubsystemRedis), flam.DefaultSubsystemRedis)
+			assert.Equal(t, config.Get(flam.PathSubsystemCache), flam.DefaultSubsystemCache)
+			assert.Equal(t, config.Get(flam.PathSubsystemValidator), flam.DefaultSubsystemValidator)
+			assert.Equal(t, config.Get(flam.PathSubsystemKennel), flam.DefaultSubsystemKennel)
+		}))
+	})
+}
+
+func Test_Provider_Subsystems(t *testing.T) {
+	t.Run("should not register the database subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"database": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.Error(t, app.Container().Invoke(func(flam.DatabaseConnectionFactory) {}))
+		assert.Error(t, app.Container().Invoke(func(flam.MigratorFactory) {}))
+
+		assert.NoError(t, app.Container().Invoke(func(config flam.Config, health flam.Health) {
+			assert.False(t, config.Bool(flam.PathSubsystemDatabase))
+			assert.False(t, config.Bool(flam.PathSubsystemMigrator))
+			assert.NotContains(t, health.Checkers(), flam.HealthCheckerDatabase)
+		}))
+	})
+
+	t.Run("should not register the migrator subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"migrator": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(flam.DatabaseConnectionFactory) {}))
+		assert.Error(t, app.Container().Invoke(func(flam.MigratorFactory) {}))
+
+		assert.NoError(t, app.Container().Invoke(func(commander flam.Commander) {
+			assert.NotContains(t, commander.Commands(), flam.CommandMigratorList)
+			assert.Contains(t, commander.Commands(), flam.CommandConfigSources)
+		}))
+	})
+
+	t.Run("should not register the redis subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"redis": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.Error(t, app.Container().Invoke(func(flam.RedisConnectionFactory) {}))
+		assert.NoError(t, app.Container().Invoke(func(flam.CacheAdaptorFactory) {}))
+		assert.NoError(t, app.Container().Invoke(func(health flam.Health) {
+			assert.NotContains(t, health.Checkers(), flam.HealthCheckerRedis)
 		}))
 	})
+
+	t.Run("should not register the cache subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"cache": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.Error(t, app.Container().Invoke(func(flam.CacheAdaptorFactory) {}))
+		assert.NoError(t, app.Container().Invoke(func(flam.RedisConnectionFactory) {}))
+	})
+
+	t.Run("should not register the validator subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"validator": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.Error(t, app.Container().Invoke(func(flam.ValidatorFactory) {}))
+		assert.Error(t, app.Container().Invoke(func(flam.TranslatorFactory) {}))
+	})
+
+	t.Run("should not register nor run the kennel subsystem when disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{"kennel": false}}})
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Run())
+
+		assert.Error(t, app.Container().Invoke(func(flam.Kennel) {}))
+		assert.Error(t, app.Container().Invoke(func(flam.AdminProcess) {}))
+	})
+
+	t.Run("should boot, run and close with every optional subsystem disabled", func(t *testing.T) {
+		app := flam.NewApplication(flam.Bag{"flam": flam.Bag{"subsystems": flam.Bag{
+			"database":  false,
+			"redis":     false,
+			"cache":     false,
+			"validator": false,
+			"kennel":    false}}})
+
+		require.NoError(t, app.Boot())
+		require.NoError(t, app.Run())
+		assert.NoError(t, app.Close())
+	})
 }
 
 func Test_Provider_Boot(t *testing.T) {
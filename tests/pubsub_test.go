@@ -156,7 +156,13 @@ func Test_PubSub_Publish(t *testing.T) {
 		assert.NoError(t, ps.Subscribe("id2", "channel", handler2))
 		assert.NoError(t, ps.Subscribe("id3", "channel", handler3))
 
-		assert.ErrorIs(t, ps.Publish("channel", "data"), expectedErr)
+		e := ps.Publish("channel", "data")
+		assert.ErrorIs(t, e, expectedErr)
+		assert.ErrorIs(t, e, flam.ErrPublishFailed)
+
+		var multiErr *flam.MultiError
+		require.ErrorAs(t, e, &multiErr)
+		assert.Equal(t, []flam.MultiErrorEntry{{Origin: "id2", Err: expectedErr}}, multiErr.Entries())
 
 		assert.True(t, published1)
 		assert.True(t, published2)
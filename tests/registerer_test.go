@@ -29,7 +29,7 @@ func Test_Registerer_Run(t *testing.T) {
 	t.Run("should return an error if providing a non-function constructor fails", func(t *testing.T) {
 		registerer := flam.NewRegisterer().Queue(struct{}{})
 
-		assert.Error(t, registerer.Run(dig.New()))
+		assert.ErrorIs(t, registerer.Run(dig.New()), flam.ErrRegistrationFailed)
 	})
 
 	t.Run("should successfully provide a constructor to the container", func(t *testing.T) {
@@ -0,0 +1,97 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cjdias/flam-in-go"
+)
+
+func Test_WarmupBooter_Boot(t *testing.T) {
+	t.Run("should not generate any resource if not configured to do so", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": "invalid"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.RedisConnectionFactory) {
+			assert.Empty(t, factory.Stored())
+		}))
+	})
+
+	t.Run("should generate the resources flagged to boot", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathRedisMiniBoot, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"alpha": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini,
+				"boot":   true},
+			"zulu": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.RedisConnectionFactory) {
+			assert.Equal(t, []string{"alpha"}, factory.Stored())
+		}))
+	})
+
+	t.Run("should generate all the resources if globally configured to do so", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathFactoryWarmup, true)
+		_ = config.Set(flam.PathRedisMiniBoot, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"alpha": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini},
+			"zulu": flam.Bag{
+				"driver": flam.RedisConnectionDriverMini}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		require.NoError(t, app.Boot())
+
+		assert.NoError(t, app.Container().Invoke(func(factory flam.RedisConnectionFactory) {
+			assert.Equal(t, []string{"alpha", "zulu"}, factory.Stored())
+		}))
+	})
+
+	t.Run("should return all the creation errors across factories", func(t *testing.T) {
+		config := flam.Bag{}
+		_ = config.Set(flam.PathFactoryWarmup, true)
+		_ = config.Set(flam.PathRedisConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"driver": "invalid"}})
+		_ = config.Set(flam.PathDatabaseConnections, flam.Bag{
+			"my_connection": flam.Bag{
+				"config": "unknown"}})
+
+		app := flam.NewApplication(config)
+		defer func() { _ = app.Close() }()
+
+		e := app.Boot()
+		assert.ErrorIs(t, e, flam.ErrWarmupFailed)
+		assert.ErrorIs(t, e, flam.ErrUnacceptedResourceConfig)
+
+		assert.ErrorIs(t, e, flam.ErrInvalidResourceConfig)
+
+		var bootErr *flam.MultiError
+		require.ErrorAs(t, e, &bootErr)
+		require.Equal(t, 1, bootErr.Len())
+
+		var warmupErr *flam.MultiError
+		require.ErrorAs(t, bootErr.Entries()[0].Err, &warmupErr)
+		assert.Len(t, warmupErr.Origin(flam.PathRedisConnections+".my_connection"), 1)
+		assert.Len(t, warmupErr.Origin(flam.PathDatabaseConnections+".my_connection"), 1)
+	})
+}
@@ -23,6 +23,7 @@ type translatorFactoryArgs struct {
 
 	Creators      []TranslatorCreator `group:"flam.translators.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newTranslatorFactory(
@@ -37,5 +38,6 @@ func newTranslatorFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("Translator"),
-		PathTranslators)
+		PathTranslators,
+		args.PubSub)
 }
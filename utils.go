@@ -1,6 +1,13 @@
 package flam
 
-import "reflect"
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
 
 func mergeContext(ctx ...Bag) Bag {
 	context := Bag{}
@@ -23,6 +30,22 @@ func asBag(
 	}
 }
 
+func asStrings(
+	value any,
+) []string {
+	var result []string
+	switch v := value.(type) {
+	case []string:
+		result = v
+	case []any:
+		for _, i := range v {
+			result = append(result, fmt.Sprintf("%v", i))
+		}
+	}
+
+	return result
+}
+
 func get[T any](
 	bag *Bag,
 	path string,
@@ -40,3 +63,58 @@ func isNil(resource any) bool {
 	}
 	return reflect.ValueOf(resource).IsNil()
 }
+
+func withContext(
+	ctx context.Context,
+	action func() error,
+) error {
+	if e := ctx.Err(); e != nil {
+		return e
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- action()
+	}()
+
+	select {
+	case e := <-done:
+		return e
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func publish(
+	pubSub PubSub[string, string],
+	channel string,
+	data ...any,
+) {
+	if pubSub == nil {
+		return
+	}
+
+	// Error ignored - events are informative, subscriber failures shouldn't block the publisher
+	_ = pubSub.Publish(channel, data...)
+}
+
+var callbackClosureSuffix = regexp.MustCompile(`(-fm|\.func\d+|\.\d+)+$`)
+
+func callbackName(
+	callback any,
+) string {
+	value := reflect.ValueOf(callback)
+	if value.Kind() != reflect.Func {
+		return ""
+	}
+
+	function := runtime.FuncForPC(value.Pointer())
+	if function == nil {
+		return ""
+	}
+
+	name := function.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	return callbackClosureSuffix.ReplaceAllString(name, "")
+}
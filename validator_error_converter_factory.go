@@ -23,6 +23,7 @@ type validatorErrorConverterFactoryArgs struct {
 
 	Creators      []ValidatorErrorConverterCreator `group:"flam.validators.converters.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newValidatorErrorConverterFactory(
@@ -37,5 +38,6 @@ func newValidatorErrorConverterFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("ValidatorErrorConverter"),
-		PathValidatorErrorConverters)
+		PathValidatorErrorConverters,
+		args.PubSub)
 }
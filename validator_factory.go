@@ -23,6 +23,7 @@ type validatorFactoryArgs struct {
 
 	Creators      []ValidatorCreator `group:"flam.validators.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newValidatorFactory(
@@ -37,5 +38,6 @@ func newValidatorFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("Validator"),
-		PathValidators)
+		PathValidators,
+		args.PubSub)
 }
@@ -23,6 +23,7 @@ type validatorParserFactoryArgs struct {
 
 	Creators      []ValidatorParserCreator `group:"flam.validators.parsers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newValidatorParserFactory(
@@ -37,5 +38,6 @@ func newValidatorParserFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("ValidatorParser"),
-		PathValidatorParsers)
+		PathValidatorParsers,
+		args.PubSub)
 }
@@ -0,0 +1,93 @@
+package flam
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/dig"
+)
+
+type factoryWarmer interface {
+	Warmup(all bool) error
+}
+
+type warmupBooter struct {
+	config    Config
+	factories []any
+}
+
+func newWarmupBooter(args struct {
+	dig.In
+
+	Config                         Config
+	DiskFactory                    DiskFactory
+	ConfigParserFactory            ConfigParserFactory
+	LogSerializerFactory           LogSerializerFactory
+	DatabaseConfigFactory          DatabaseConfigFactory          `optional:"true"`
+	DatabaseDialectFactory         DatabaseDialectFactory         `optional:"true"`
+	DatabaseConnectionFactory      DatabaseConnectionFactory      `optional:"true"`
+	MigratorLoggerFactory          MigratorLoggerFactory          `optional:"true"`
+	MigratorFactory                MigratorFactory                `optional:"true"`
+	RedisConnectionFactory         RedisConnectionFactory         `optional:"true"`
+	CacheKeyGeneratorFactory       CacheKeyGeneratorFactory       `optional:"true"`
+	CacheSerializerFactory         CacheSerializerFactory         `optional:"true"`
+	CacheAdaptorFactory            CacheAdaptorFactory            `optional:"true"`
+	TranslatorFactory              TranslatorFactory              `optional:"true"`
+	ValidatorParserFactory         ValidatorParserFactory         `optional:"true"`
+	ValidatorErrorConverterFactory ValidatorErrorConverterFactory `optional:"true"`
+	ValidatorFactory               ValidatorFactory               `optional:"true"`
+	WatchdogLoggerFactory          WatchdogLoggerFactory          `optional:"true"`
+}) *warmupBooter {
+	return &warmupBooter{
+		config: args.Config,
+		factories: []any{
+			args.DiskFactory,
+			args.ConfigParserFactory,
+			args.LogSerializerFactory,
+			args.DatabaseConfigFactory,
+			args.DatabaseDialectFactory,
+			args.DatabaseConnectionFactory,
+			args.MigratorLoggerFactory,
+			args.MigratorFactory,
+			args.RedisConnectionFactory,
+			args.CacheKeyGeneratorFactory,
+			args.CacheSerializerFactory,
+			args.CacheAdaptorFactory,
+			args.TranslatorFactory,
+			args.ValidatorParserFactory,
+			args.ValidatorErrorConverterFactory,
+			args.ValidatorFactory,
+			args.WatchdogLoggerFactory}}
+}
+
+func (booter *warmupBooter) Boot(
+	ctx context.Context,
+) error {
+	all := booter.config.Bool(PathFactoryWarmup)
+
+	errs := NewMultiError(ErrWarmupFailed)
+	for _, factory := range booter.factories {
+		if e := ctx.Err(); e != nil {
+			return e
+		}
+
+		warmer, ok := factory.(factoryWarmer)
+		if !ok {
+			continue
+		}
+
+		e := warmer.Warmup(all)
+
+		var multiError *MultiError
+		if errors.As(e, &multiError) {
+			for _, entry := range multiError.Entries() {
+				errs.Add(entry.Origin, entry.Err)
+			}
+			continue
+		}
+
+		errs.Add("", e)
+	}
+
+	return errs.ErrorOrNil()
+}
@@ -5,17 +5,23 @@ import "sync"
 type watchdog struct {
 	mu             sync.Mutex
 	isRunning      bool
+	id             string
 	process        Process
 	watchdogLogger WatchdogLogger
+	pubSub         PubSub[string, string]
 }
 
 func newWatchdog(
+	id string,
 	process Process,
 	watchdogLogger WatchdogLogger,
+	pubSub PubSub[string, string],
 ) *watchdog {
 	return &watchdog{
+		id:             id,
 		process:        process,
 		watchdogLogger: watchdogLogger,
+		pubSub:         pubSub,
 	}
 }
 
@@ -53,10 +59,12 @@ func (watchdog *watchdog) Run() error {
 	watchdog.mu.Lock()
 	watchdog.isRunning = true
 	watchdog.mu.Unlock()
+	publish(watchdog.pubSub, EventProcessStarted, watchdog.id)
 	for {
 		e = runner()
 		if panicErr != nil {
 			watchdog.logError(panicErr)
+			publish(watchdog.pubSub, EventProcessCrashed, watchdog.id, panicErr)
 			panicErr = nil
 
 			continue
@@ -68,6 +76,7 @@ func (watchdog *watchdog) Run() error {
 	watchdog.isRunning = false
 	watchdog.mu.Unlock()
 	watchdog.logDone()
+	publish(watchdog.pubSub, EventProcessStopped, watchdog.id, e)
 
 	return e
 }
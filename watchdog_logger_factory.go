@@ -23,6 +23,7 @@ type watchddogLoggerFactoryArgs struct {
 
 	Creators      []WatchdogLoggerCreator `group:"flam.watchdog.loggers.creator"`
 	FactoryConfig FactoryConfig
+	PubSub        PubSub[string, string]
 }
 
 func newWatchdogLoggerFactory(
@@ -37,5 +38,6 @@ func newWatchdogLoggerFactory(
 		creators,
 		args.FactoryConfig,
 		DriverFactoryConfigValidator("WatchdogLogger"),
-		PathWatchdogLoggers)
+		PathWatchdogLoggers,
+		args.PubSub)
 }